@@ -0,0 +1,540 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AnalyzerResource{}
+var _ resource.ResourceWithImportState = &AnalyzerResource{}
+var _ resource.ResourceWithModifyPlan = &AnalyzerResource{}
+
+func NewAnalyzerResource() resource.Resource {
+	return &AnalyzerResource{}
+}
+
+// AnalyzerResource defines the resource implementation.
+type AnalyzerResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+	server         *ServerInfo
+}
+
+// AnalyzerResourceModel describes the resource data model.
+type AnalyzerResourceModel struct {
+	Database   types.String         `tfsdk:"database"`
+	Features   types.Set            `tfsdk:"features"`
+	Force      types.Bool           `tfsdk:"force"`
+	Name       types.String         `tfsdk:"name"`
+	Properties jsontypes.Normalized `tfsdk:"properties"`
+	Timeouts   timeouts.Value       `tfsdk:"timeouts"`
+	Type       types.String         `tfsdk:"type"`
+}
+
+// analyzerDefinition is the analyzer as exchanged with the server. The properties are kept
+// as raw JSON because the typed driver definition drops unknown and adds empty properties.
+type analyzerDefinition struct {
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Properties json.RawMessage `json:"properties,omitempty"`
+	Features   []string        `json:"features"`
+}
+
+var analyzerTypes = []string{
+	string(arangodb.ArangoSearchAnalyzerTypeIdentity),
+	string(arangodb.ArangoSearchAnalyzerTypeDelimiter),
+	string(arangodb.ArangoSearchAnalyzerTypeMultiDelimiter),
+	string(arangodb.ArangoSearchAnalyzerTypeStem),
+	string(arangodb.ArangoSearchAnalyzerTypeNorm),
+	string(arangodb.ArangoSearchAnalyzerTypeNGram),
+	string(arangodb.ArangoSearchAnalyzerTypeText),
+	string(arangodb.ArangoSearchAnalyzerTypeAQL),
+	string(arangodb.ArangoSearchAnalyzerTypePipeline),
+	string(arangodb.ArangoSearchAnalyzerTypeStopwords),
+	string(arangodb.ArangoSearchAnalyzerTypeGeoJSON),
+	string(arangodb.ArangoSearchAnalyzerTypeGeoS2),
+	string(arangodb.ArangoSearchAnalyzerTypeGeoPoint),
+	string(arangodb.ArangoSearchAnalyzerTypeSegmentation),
+	string(arangodb.ArangoSearchAnalyzerTypeCollation),
+	string(arangodb.ArangoSearchAnalyzerTypeClassification),
+	string(arangodb.ArangoSearchAnalyzerTypeNearestNeighbors),
+	string(arangodb.ArangoSearchAnalyzerTypeMinhash),
+	string(arangodb.ArangoSearchAnalyzerTypeWildcard),
+}
+
+// analyzerTypeRequirements lists the analyzer types which are not supported by every server.
+var analyzerTypeRequirements = map[string]serverRequirement{
+	string(arangodb.ArangoSearchAnalyzerTypeSegmentation):     {feature: "The segmentation Analyzer", minVersion: "3.9.0"},
+	string(arangodb.ArangoSearchAnalyzerTypeCollation):        {feature: "The collation Analyzer", minVersion: "3.9.0"},
+	string(arangodb.ArangoSearchAnalyzerTypeClassification):   {feature: "The classification Analyzer", minVersion: "3.10.0", enterprise: true},
+	string(arangodb.ArangoSearchAnalyzerTypeNearestNeighbors): {feature: "The nearest_neighbors Analyzer", minVersion: "3.10.0", enterprise: true},
+	string(arangodb.ArangoSearchAnalyzerTypeMinhash):          {feature: "The minhash Analyzer", minVersion: "3.10.0", enterprise: true},
+	string(arangodb.ArangoSearchAnalyzerTypeGeoS2):            {feature: "The geo_s2 Analyzer", minVersion: "3.10.5", enterprise: true},
+	string(arangodb.ArangoSearchAnalyzerTypeMultiDelimiter):   {feature: "The multi_delimiter Analyzer", minVersion: "3.12.0"},
+	string(arangodb.ArangoSearchAnalyzerTypeWildcard):         {feature: "The wildcard Analyzer", minVersion: "3.12.0"},
+}
+
+func (r *AnalyzerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analyzer"
+}
+
+func (r *AnalyzerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An Arango analyzer transforms values for ArangoSearch. Analyzers cannot be modified, any change replaces the analyzer",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"features": schema.SetAttribute{
+				MarkdownDescription: "Features set on the generated fields, can be 'frequency', 'norm', 'position' or 'offset'. Defaults to none",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(arangodb.ArangoSearchFeatureFrequency),
+						string(arangodb.ArangoSearchFeatureNorm),
+						string(arangodb.ArangoSearchFeaturePosition),
+						string(arangodb.ArangoSearchFeatureOffset),
+					)),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether the analyzer is removed even if it is still used by views or indexes, defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Analyzer name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"properties": schema.StringAttribute{
+				MarkdownDescription: "The analyzer properties as a JSON encoded string, key ordering and whitespace are ignored when comparing. " +
+					"Properties filled in by the server with default values do not cause a difference",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Analyzer type, for example 'text', 'ngram', 'norm', 'stem', 'pipeline', 'stopwords', 'geojson' or 'aql'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(analyzerTypes...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AnalyzerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+	r.server = providerData.Server
+}
+
+func (r *AnalyzerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data AnalyzerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if requirement, ok := analyzerTypeRequirements[data.Type.ValueString()]; ok {
+		resp.Diagnostics.Append(r.server.Require(path.Root("type"), requirement)...)
+	}
+}
+
+func (r *AnalyzerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AnalyzerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	definition := analyzerDefinition{
+		Name:     data.Name.ValueString(),
+		Type:     data.Type.ValueString(),
+		Features: []string{},
+	}
+
+	if !data.Properties.IsNull() && !data.Properties.IsUnknown() {
+		definition.Properties = json.RawMessage(data.Properties.ValueString())
+	}
+
+	resp.Diagnostics.Append(data.Features.ElementsAs(ctx, &definition.Features, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(data.Database.ValueString()), "_api", "analyzer")
+
+	var response shared.ResponseStruct
+
+	httpResp, err := connection.CallPost(ctx, r.client.Connection(), urlEndpoint, &response, definition)
+
+	// The server answers with 200 instead of 201 when an analyzer with the same definition already exists.
+	if err == nil && httpResp.Code() == http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Analyzer Already Exists",
+			fmt.Sprintf("The analyzer %q already exists in database %q, import it instead with the identifier %s/%s.",
+				data.Name.ValueString(), data.Database.ValueString(), data.Database.ValueString(), data.Name.ValueString()),
+		)
+
+		return
+	}
+
+	if err == nil && httpResp.Code() != http.StatusCreated {
+		err = response.AsArangoErrorWithCode(httpResp.Code())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	analyzer, err := r.readAnalyzer(ctx, data.Database.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the created resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromAnalyzerDefinition(ctx, &data, analyzer)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnalyzerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AnalyzerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	analyzer, err := r.readAnalyzer(ctx, data.Database.ValueString(), data.Name.ValueString())
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(fromAnalyzerDefinition(ctx, &data, analyzer)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnalyzerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AnalyzerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Analyzers are immutable, only force can change in place and it is used on deletion.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnalyzerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AnalyzerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	analyzer, err := database.Analyzer(ctx, data.Name.ValueString())
+	if err == nil {
+		err = analyzer.Remove(ctx, data.Force.ValueBool())
+	}
+
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *AnalyzerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/analyzer. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force"), false)...)
+}
+
+// readAnalyzer fetches the analyzer definition with its properties as returned by the server.
+func (r *AnalyzerResource) readAnalyzer(ctx context.Context, database string, name string) (analyzerDefinition, error) {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(database), "_api", "analyzer", url.PathEscape(name))
+
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		analyzerDefinition    `json:",inline"`
+	}
+
+	httpResp, err := connection.CallGet(ctx, r.client.Connection(), urlEndpoint, &response)
+	if err != nil {
+		return analyzerDefinition{}, err
+	}
+
+	if httpResp.Code() != http.StatusOK {
+		return analyzerDefinition{}, response.AsArangoErrorWithCode(httpResp.Code())
+	}
+
+	return response.analyzerDefinition, nil
+}
+
+// fromAnalyzerDefinition copies the server side analyzer definition into the model.
+// The configured properties are kept as long as the server reports the same values for them.
+func fromAnalyzerDefinition(ctx context.Context, data *AnalyzerResourceModel, analyzer analyzerDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Type = types.StringValue(analyzer.Type)
+
+	features := analyzer.Features
+	if features == nil {
+		features = []string{}
+	}
+
+	featuresValue, featuresDiags := types.SetValueFrom(ctx, types.StringType, features)
+	diags.Append(featuresDiags...)
+	data.Features = featuresValue
+
+	properties := string(analyzer.Properties)
+	if properties == "" || properties == "null" {
+		properties = "{}"
+	}
+
+	if !data.Properties.IsNull() && !data.Properties.IsUnknown() {
+		var configured, actual any
+
+		if json.Unmarshal([]byte(data.Properties.ValueString()), &configured) == nil &&
+			json.Unmarshal([]byte(properties), &actual) == nil &&
+			isJSONSubset(configured, actual) {
+			return diags
+		}
+	}
+
+	data.Properties = jsontypes.NewNormalizedValue(properties)
+
+	return diags
+}
+
+// isJSONSubset reports whether every value of subset is present with the same value in superset.
+// Objects may contain additional keys in superset, arrays must match element by element.
+func isJSONSubset(subset any, superset any) bool {
+	switch subsetValue := subset.(type) {
+	case map[string]any:
+		supersetValue, ok := superset.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range subsetValue {
+			if !isJSONSubset(value, supersetValue[key]) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		supersetValue, ok := superset.([]any)
+		if !ok || len(subsetValue) != len(supersetValue) {
+			return false
+		}
+
+		for i := range subsetValue {
+			if !isJSONSubset(subsetValue[i], supersetValue[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(subset, superset)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAnalyzerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAnalyzerResourceConfig("analyzer_database", "analyzer", "en"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_analyzer.test", "name", "analyzer"),
+					resource.TestCheckResourceAttr("arangodb_analyzer.test", "type", "text"),
+					resource.TestCheckResourceAttr("arangodb_analyzer.test", "features.#", "2"),
+					resource.TestCheckResourceAttr("arangodb_analyzer.test", "force", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "arangodb_analyzer.test",
+				ImportState:                          true,
+				ImportStateId:                        "analyzer_database/analyzer",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"force", "properties"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccAnalyzerResourceConfig("analyzer_database", "analyzer", "de"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_analyzer.test", "type", "text"),
+				),
+			},
+			// Existing analyzer testing
+			{
+				Config: testAccAnalyzerResourceConfig("analyzer_database", "analyzer", "de") + `
+resource "arangodb_analyzer" "duplicate" {
+  database = arangodb_analyzer.test.database
+  name     = arangodb_analyzer.test.name
+  type     = arangodb_analyzer.test.type
+  features = arangodb_analyzer.test.features
+
+  properties = jsonencode({
+    locale    = "de"
+    case      = "lower"
+    stemming  = true
+    stopwords = []
+  })
+}
+`,
+				ExpectError: regexp.MustCompile("Analyzer Already Exists"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestIsJSONSubset(t *testing.T) {
+	testCases := map[string]struct {
+		subset   string
+		superset string
+		expected bool
+	}{
+		"equal":           {`{"locale":"en"}`, `{"locale":"en"}`, true},
+		"server defaults": {`{"locale":"en"}`, `{"locale":"en","case":"lower","stopwords":[]}`, true},
+		"nested":          {`{"edgeNgram":{"min":2}}`, `{"edgeNgram":{"min":2,"max":4}}`, true},
+		"changed value":   {`{"locale":"en"}`, `{"locale":"de"}`, false},
+		"missing key":     {`{"locale":"en","case":"upper"}`, `{"locale":"en"}`, false},
+		"array length":    {`{"stopwords":["a"]}`, `{"stopwords":["a","b"]}`, false},
+		"type mismatch":   {`{"min":1}`, `{"min":"1"}`, false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var subset, superset any
+
+			if err := json.Unmarshal([]byte(testCase.subset), &subset); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.superset), &superset); err != nil {
+				t.Fatal(err)
+			}
+
+			if actual := isJSONSubset(subset, superset); actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func testAccAnalyzerResourceConfig(databaseName string, name string, locale string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_analyzer" "test" {
+  database = arangodb_database.test.name
+  name     = %[2]q
+  type     = "text"
+  features = ["frequency", "norm"]
+  force    = true
+
+  properties = jsonencode({
+    locale    = %[3]q
+    case      = "lower"
+    stemming  = true
+    stopwords = []
+  })
+}
+`, databaseName, name, locale)
+}
@@ -0,0 +1,997 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CollectionResource{}
+var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource defines the resource implementation.
+type CollectionResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+	server         *ServerInfo
+}
+
+// CollectionResourceModel describes the resource data model.
+type CollectionResourceModel struct {
+	CacheEnabled      types.Bool     `tfsdk:"cache_enabled"`
+	ComputedValues    types.List     `tfsdk:"computed_values"`
+	Database          types.String   `tfsdk:"database"`
+	KeyOptions        types.Object   `tfsdk:"key_options"`
+	Name              types.String   `tfsdk:"name"`
+	NumberOfShards    types.Int64    `tfsdk:"number_of_shards"`
+	ReplicationFactor types.Int64    `tfsdk:"replication_factor"`
+	Schema            types.Object   `tfsdk:"schema"`
+	ShardKeys         types.List     `tfsdk:"shard_keys"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	Type              types.String   `tfsdk:"type"`
+	WaitForSync       types.Bool     `tfsdk:"wait_for_sync"`
+	WriteConcern      types.Int64    `tfsdk:"write_concern"`
+}
+
+// CollectionKeyOptionsModel describes the key generator of a collection.
+type CollectionKeyOptionsModel struct {
+	AllowUserKeys types.Bool   `tfsdk:"allow_user_keys"`
+	Increment     types.Int64  `tfsdk:"increment"`
+	Offset        types.Int64  `tfsdk:"offset"`
+	Type          types.String `tfsdk:"type"`
+}
+
+// CollectionSchemaModel describes the JSON schema validation of a collection.
+type CollectionSchemaModel struct {
+	Level   types.String         `tfsdk:"level"`
+	Message types.String         `tfsdk:"message"`
+	Rule    jsontypes.Normalized `tfsdk:"rule"`
+}
+
+// CollectionComputedValueModel describes a single computed value of a collection.
+type CollectionComputedValueModel struct {
+	ComputeOn     types.Set    `tfsdk:"compute_on"`
+	Expression    types.String `tfsdk:"expression"`
+	FailOnWarning types.Bool   `tfsdk:"fail_on_warning"`
+	KeepNull      types.Bool   `tfsdk:"keep_null"`
+	Name          types.String `tfsdk:"name"`
+	Overwrite     types.Bool   `tfsdk:"overwrite"`
+}
+
+var collectionKeyOptionsAttrTypes = map[string]attr.Type{
+	"allow_user_keys": types.BoolType,
+	"increment":       types.Int64Type,
+	"offset":          types.Int64Type,
+	"type":            types.StringType,
+}
+
+var collectionSchemaAttrTypes = map[string]attr.Type{
+	"level":   types.StringType,
+	"message": types.StringType,
+	"rule":    jsontypes.NormalizedType{},
+}
+
+var collectionComputedValueAttrTypes = map[string]attr.Type{
+	"compute_on":      types.SetType{ElemType: types.StringType},
+	"expression":      types.StringType,
+	"fail_on_warning": types.BoolType,
+	"keep_null":       types.BoolType,
+	"name":            types.StringType,
+	"overwrite":       types.BoolType,
+}
+
+var collectionTypes = map[string]arangodb.CollectionType{
+	"document": arangodb.CollectionTypeDocument,
+	"edge":     arangodb.CollectionTypeEdge,
+}
+
+func (r *CollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *CollectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An Arango collection stores documents or edges inside a database",
+
+		Attributes: map[string]schema.Attribute{
+			"cache_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the in-memory hash cache for documents is enabled, defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"computed_values": schema.ListNestedAttribute{
+				MarkdownDescription: "Document attributes generated on write operations using AQL expressions",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_on": schema.SetAttribute{
+							MarkdownDescription: "Write operations on which the value is computed, can be 'insert', 'update' or 'replace'. Defaults to all of them",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+								types.StringValue(string(arangodb.ComputeOnInsert)),
+								types.StringValue(string(arangodb.ComputeOnUpdate)),
+								types.StringValue(string(arangodb.ComputeOnReplace)),
+							})),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(
+									string(arangodb.ComputeOnInsert),
+									string(arangodb.ComputeOnUpdate),
+									string(arangodb.ComputeOnReplace),
+								)),
+							},
+						},
+						"expression": schema.StringAttribute{
+							MarkdownDescription: "An AQL `RETURN` operation computing the value",
+							Required:            true,
+						},
+						"fail_on_warning": schema.BoolAttribute{
+							MarkdownDescription: "Whether the write operation fails if the expression produces a warning, defaults to false",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"keep_null": schema.BoolAttribute{
+							MarkdownDescription: "Whether the result is stored if the expression evaluates to `null`, defaults to true",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the top-level target attribute",
+							Required:            true,
+						},
+						"overwrite": schema.BoolAttribute{
+							MarkdownDescription: "Whether the computed value takes precedence over a user-provided or existing attribute",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"key_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Specifies how document keys are generated, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"allow_user_keys": schema.BoolAttribute{
+						MarkdownDescription: "Whether documents may supply their own `_key` values",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"increment": schema.Int64Attribute{
+						MarkdownDescription: "Increment value of the `autoincrement` key generator",
+						Optional:            true,
+					},
+					"offset": schema.Int64Attribute{
+						MarkdownDescription: "Initial offset of the `autoincrement` key generator",
+						Optional:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Key generator type, can be 'traditional', 'autoincrement', 'uuid' or 'padded'",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("traditional", "autoincrement", "uuid", "padded"),
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Collection name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"number_of_shards": schema.Int64Attribute{
+				MarkdownDescription: "Number of shards of the collection in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "Number of copies kept of each shard in a cluster",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schema": schema.SingleNestedAttribute{
+				MarkdownDescription: "JSON schema validation applied to documents written to the collection",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"level": schema.StringAttribute{
+						MarkdownDescription: "When the validation is applied, can be 'none', 'new', 'moderate' or 'strict', defaults to 'strict'",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(arangodb.CollectionSchemaLevelStrict)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(arangodb.CollectionSchemaLevelNone),
+								string(arangodb.CollectionSchemaLevelNew),
+								string(arangodb.CollectionSchemaLevelModerate),
+								string(arangodb.CollectionSchemaLevelStrict),
+							),
+						},
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "The error message returned when a document fails the validation, removing it restores the default message of the server",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"rule": schema.StringAttribute{
+						MarkdownDescription: "The JSON schema as a JSON encoded string, key ordering and whitespace are ignored when comparing",
+						CustomType:          jsontypes.NormalizedType{},
+						Required:            true,
+					},
+				},
+			},
+			"shard_keys": schema.ListAttribute{
+				MarkdownDescription: "Document attributes used to determine the target shard, cannot be changed after creation",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Collection type, can be 'document' or 'edge', defaults to 'document'",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("document"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("document", "edge"),
+				},
+			},
+			"wait_for_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether write operations wait until the data is synchronized to disk, defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"write_concern": schema.Int64Attribute{
+				MarkdownDescription: "Number of in-sync copies required before a shard accepts writes in a cluster",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+	r.server = providerData.Server
+}
+
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data CollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.ComputedValues.Elements()) > 0 {
+		resp.Diagnostics.Append(r.server.Require(path.Root("computed_values"), serverRequirement{
+			feature:    "Computed values",
+			minVersion: "3.10.0",
+		})...)
+	}
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	properties, diags := toCreateCollectionProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	collection, err := database.CreateCollectionV2(ctx, data.Name.ValueString(), properties)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	collectionProperties, err := collection.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the created resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromCollectionProperties(ctx, &data, collectionProperties)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Database",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Name.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	properties, err := collection.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromCollectionProperties(ctx, &data, properties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	options, diags := toSetCollectionPropertiesOptions(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Collection",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	err = collection.SetPropertiesV2(ctx, options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	properties, err := collection.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the updated resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromCollectionProperties(ctx, &data, properties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Name.ValueString(), &arangodb.GetCollectionOptions{
+		SkipExistCheck: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	err = collection.Remove(ctx)
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/collection. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func toCreateCollectionProperties(ctx context.Context, data CollectionResourceModel) (*arangodb.CreateCollectionPropertiesV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	collectionType := collectionTypes[data.Type.ValueString()]
+
+	properties := &arangodb.CreateCollectionPropertiesV2{
+		CacheEnabled: data.CacheEnabled.ValueBoolPointer(),
+		Type:         &collectionType,
+		WaitForSync:  data.WaitForSync.ValueBoolPointer(),
+	}
+
+	if !data.KeyOptions.IsNull() && !data.KeyOptions.IsUnknown() {
+		var keyOptions CollectionKeyOptionsModel
+
+		diags.Append(data.KeyOptions.As(ctx, &keyOptions, basetypes.ObjectAsOptions{})...)
+
+		properties.KeyOptions = &arangodb.CollectionKeyOptions{
+			AllowUserKeysPtr: keyOptions.AllowUserKeys.ValueBoolPointer(),
+			Type:             arangodb.KeyGeneratorType(keyOptions.Type.ValueString()),
+			Increment:        int(keyOptions.Increment.ValueInt64()),
+			Offset:           int(keyOptions.Offset.ValueInt64()),
+		}
+	}
+
+	if !data.NumberOfShards.IsNull() && !data.NumberOfShards.IsUnknown() {
+		numberOfShards := int(data.NumberOfShards.ValueInt64())
+		properties.NumberOfShards = &numberOfShards
+	}
+
+	if !data.ReplicationFactor.IsNull() && !data.ReplicationFactor.IsUnknown() {
+		replicationFactor := arangodb.ReplicationFactor(data.ReplicationFactor.ValueInt64())
+		properties.ReplicationFactor = &replicationFactor
+	}
+
+	if !data.ShardKeys.IsNull() && !data.ShardKeys.IsUnknown() {
+		var shardKeys []string
+
+		diags.Append(data.ShardKeys.ElementsAs(ctx, &shardKeys, false)...)
+
+		properties.ShardKeys = &shardKeys
+	}
+
+	if !data.WriteConcern.IsNull() && !data.WriteConcern.IsUnknown() {
+		writeConcern := int(data.WriteConcern.ValueInt64())
+		properties.WriteConcern = &writeConcern
+	}
+
+	if !data.ComputedValues.IsNull() {
+		computedValues, computedValuesDiags := toComputedValues(ctx, data.ComputedValues)
+		diags.Append(computedValuesDiags...)
+
+		properties.ComputedValues = &computedValues
+	}
+
+	if !data.Schema.IsNull() {
+		collectionSchema, schemaDiags := toCollectionSchemaOptions(ctx, data.Schema)
+		diags.Append(schemaDiags...)
+
+		properties.Schema = collectionSchema
+	}
+
+	return properties, diags
+}
+
+func toSetCollectionPropertiesOptions(ctx context.Context, data CollectionResourceModel) (arangodb.SetCollectionPropertiesOptionsV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := arangodb.SetCollectionPropertiesOptionsV2{
+		CacheEnabled: data.CacheEnabled.ValueBoolPointer(),
+		WaitForSync:  data.WaitForSync.ValueBoolPointer(),
+	}
+
+	if !data.ReplicationFactor.IsNull() && !data.ReplicationFactor.IsUnknown() {
+		replicationFactor := arangodb.ReplicationFactor(data.ReplicationFactor.ValueInt64())
+		options.ReplicationFactor = &replicationFactor
+	}
+
+	if !data.WriteConcern.IsNull() && !data.WriteConcern.IsUnknown() {
+		writeConcern := int(data.WriteConcern.ValueInt64())
+		options.WriteConcern = &writeConcern
+	}
+
+	// Always send the computed values so that removing them from the configuration clears them on the server.
+	computedValues, computedValuesDiags := toComputedValues(ctx, data.ComputedValues)
+	diags.Append(computedValuesDiags...)
+
+	options.ComputedValues = &computedValues
+
+	// An empty schema removes the validation from the collection.
+	options.Schema = &arangodb.CollectionSchemaOptions{}
+
+	if !data.Schema.IsNull() {
+		collectionSchema, schemaDiags := toCollectionSchemaOptions(ctx, data.Schema)
+		diags.Append(schemaDiags...)
+
+		options.Schema = collectionSchema
+	}
+
+	return options, diags
+}
+
+func toCollectionSchemaOptions(ctx context.Context, object types.Object) (*arangodb.CollectionSchemaOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model CollectionSchemaModel
+
+	diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	options := &arangodb.CollectionSchemaOptions{
+		Level:   arangodb.CollectionSchemaLevel(model.Level.ValueString()),
+		Message: model.Message.ValueString(),
+	}
+
+	if err := options.LoadRule([]byte(model.Rule.ValueString())); err != nil {
+		diags.AddAttributeError(
+			path.Root("schema").AtName("rule"),
+			"Invalid Schema Rule",
+			"The schema rule could not be parsed as JSON: "+err.Error(),
+		)
+	}
+
+	return options, diags
+}
+
+func toComputedValues(ctx context.Context, list types.List) ([]arangodb.ComputedValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []CollectionComputedValueModel
+
+	computedValues := []arangodb.ComputedValue{}
+
+	if list.IsNull() || list.IsUnknown() {
+		return computedValues, diags
+	}
+
+	diags.Append(list.ElementsAs(ctx, &models, false)...)
+
+	for _, model := range models {
+		var computeOn []string
+
+		diags.Append(model.ComputeOn.ElementsAs(ctx, &computeOn, false)...)
+
+		computedValue := arangodb.ComputedValue{
+			Name:          model.Name.ValueString(),
+			Expression:    model.Expression.ValueString(),
+			Overwrite:     model.Overwrite.ValueBool(),
+			FailOnWarning: model.FailOnWarning.ValueBoolPointer(),
+			KeepNull:      model.KeepNull.ValueBoolPointer(),
+		}
+
+		for _, operation := range computeOn {
+			computedValue.ComputeOn = append(computedValue.ComputeOn, arangodb.ComputeOn(operation))
+		}
+
+		computedValues = append(computedValues, computedValue)
+	}
+
+	return computedValues, diags
+}
+
+// fromCollectionProperties copies the server side collection properties into the model.
+// Cluster only properties are left untouched when the server does not report them.
+func fromCollectionProperties(ctx context.Context, data *CollectionResourceModel, properties arangodb.CollectionProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, collectionType := range collectionTypes {
+		if collectionType == properties.Type {
+			data.Type = types.StringValue(name)
+		}
+	}
+
+	data.CacheEnabled = types.BoolValue(properties.CacheEnabled)
+	data.Name = types.StringValue(properties.Name)
+	data.WaitForSync = types.BoolValue(properties.WaitForSync)
+
+	// The server does not report increment and offset, keep the configured values.
+	keyOptions := CollectionKeyOptionsModel{
+		AllowUserKeys: types.BoolValue(properties.KeyOptions.AllowUserKeys),
+		Increment:     types.Int64Null(),
+		Offset:        types.Int64Null(),
+		Type:          types.StringValue(string(properties.KeyOptions.Type)),
+	}
+
+	if !data.KeyOptions.IsNull() && !data.KeyOptions.IsUnknown() {
+		var prior CollectionKeyOptionsModel
+
+		diags.Append(data.KeyOptions.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+
+		keyOptions.Increment = prior.Increment
+		keyOptions.Offset = prior.Offset
+	}
+
+	keyOptionsValue, keyOptionsDiags := types.ObjectValueFrom(ctx, collectionKeyOptionsAttrTypes, keyOptions)
+	diags.Append(keyOptionsDiags...)
+	data.KeyOptions = keyOptionsValue
+
+	if properties.NumberOfShards > 0 {
+		data.NumberOfShards = types.Int64Value(int64(properties.NumberOfShards))
+	} else if data.NumberOfShards.IsUnknown() {
+		data.NumberOfShards = types.Int64Null()
+	}
+
+	if properties.ReplicationFactor != 0 {
+		data.ReplicationFactor = types.Int64Value(int64(properties.ReplicationFactor))
+	} else if data.ReplicationFactor.IsUnknown() {
+		data.ReplicationFactor = types.Int64Null()
+	}
+
+	if properties.WriteConcern > 0 {
+		data.WriteConcern = types.Int64Value(int64(properties.WriteConcern))
+	} else if data.WriteConcern.IsUnknown() {
+		data.WriteConcern = types.Int64Null()
+	}
+
+	if len(properties.ShardKeys) > 0 {
+		shardKeys, shardKeysDiags := types.ListValueFrom(ctx, types.StringType, properties.ShardKeys)
+		diags.Append(shardKeysDiags...)
+		data.ShardKeys = shardKeys
+	} else if data.ShardKeys.IsUnknown() {
+		data.ShardKeys = types.ListNull(types.StringType)
+	}
+
+	diags.Append(fromCollectionSchemaOptions(ctx, data, properties.Schema)...)
+
+	computedValueType := types.ObjectType{AttrTypes: collectionComputedValueAttrTypes}
+
+	if len(properties.ComputedValues) == 0 {
+		if data.ComputedValues.IsNull() || data.ComputedValues.IsUnknown() {
+			data.ComputedValues = types.ListNull(computedValueType)
+		} else {
+			data.ComputedValues = types.ListValueMust(computedValueType, []attr.Value{})
+		}
+
+		return diags
+	}
+
+	computedValues := make([]CollectionComputedValueModel, 0, len(properties.ComputedValues))
+
+	for _, computedValue := range properties.ComputedValues {
+		computeOn := make([]string, 0, len(computedValue.ComputeOn))
+		for _, operation := range computedValue.ComputeOn {
+			computeOn = append(computeOn, string(operation))
+		}
+
+		computeOnValue, computeOnDiags := types.SetValueFrom(ctx, types.StringType, computeOn)
+		diags.Append(computeOnDiags...)
+
+		model := CollectionComputedValueModel{
+			ComputeOn:     computeOnValue,
+			Expression:    types.StringValue(computedValue.Expression),
+			FailOnWarning: types.BoolValue(false),
+			KeepNull:      types.BoolValue(true),
+			Name:          types.StringValue(computedValue.Name),
+			Overwrite:     types.BoolValue(computedValue.Overwrite),
+		}
+
+		if computedValue.FailOnWarning != nil {
+			model.FailOnWarning = types.BoolValue(*computedValue.FailOnWarning)
+		}
+
+		if computedValue.KeepNull != nil {
+			model.KeepNull = types.BoolValue(*computedValue.KeepNull)
+		}
+
+		computedValues = append(computedValues, model)
+	}
+
+	computedValuesValue, computedValuesDiags := types.ListValueFrom(ctx, computedValueType, computedValues)
+	diags.Append(computedValuesDiags...)
+	data.ComputedValues = computedValuesValue
+
+	return diags
+}
+
+func fromCollectionSchemaOptions(ctx context.Context, data *CollectionResourceModel, options *arangodb.CollectionSchemaOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if options == nil || options.Rule == nil {
+		data.Schema = types.ObjectNull(collectionSchemaAttrTypes)
+
+		return diags
+	}
+
+	rule, err := json.Marshal(options.Rule)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Schema Rule",
+			"The schema rule returned by the server could not be encoded as JSON: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	// An empty message is not sent to the server, so it is kept as null.
+	message := types.StringNull()
+	if options.Message != "" {
+		message = types.StringValue(options.Message)
+	}
+
+	schemaValue, schemaDiags := types.ObjectValueFrom(ctx, collectionSchemaAttrTypes, CollectionSchemaModel{
+		Level:   types.StringValue(string(options.Level)),
+		Message: message,
+		Rule:    jsontypes.NewNormalizedValue(string(rule)),
+	})
+	diags.Append(schemaDiags...)
+	data.Schema = schemaValue
+
+	return diags
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionResourceConfig("collection_database", "collection", false, "moderate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_collection.test", "database", "collection_database"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "name", "collection"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "type", "document"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "wait_for_sync", "false"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "key_options.type", "traditional"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "computed_values.#", "1"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "computed_values.0.name", "created_at"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "schema.level", "moderate"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "schema.message", "Invalid document"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "arangodb_collection.test",
+				ImportState:                          true,
+				ImportStateId:                        "collection_database/collection",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionResourceConfig("collection_database", "collection", true, "strict"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_collection.test", "wait_for_sync", "true"),
+					resource.TestCheckResourceAttr("arangodb_collection.test", "schema.level", "strict"),
+				),
+			},
+			// Message removal testing
+			{
+				Config: strings.Replace(testAccCollectionResourceConfig("collection_database", "collection", true, "strict"), `message = "Invalid document"`, "", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("arangodb_collection.test", "schema.message"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCollectionResourceConfig(databaseName string, name string, waitForSync bool, schemaLevel string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_collection" "test" {
+  database      = arangodb_database.test.name
+  name          = %[2]q
+  wait_for_sync = %[3]t
+
+  key_options = {
+    type            = "traditional"
+    allow_user_keys = true
+  }
+
+  computed_values = [
+    {
+      name       = "created_at"
+      expression = "RETURN DATE_NOW()"
+      overwrite  = true
+      compute_on = ["insert"]
+    },
+  ]
+
+  schema = {
+    level   = %[4]q
+    message = "Invalid document"
+    rule = jsonencode({
+      type       = "object"
+      required   = ["name"]
+      properties = { name = { type = "string" } }
+    })
+  }
+}
+`, databaseName, name, waitForSync, schemaLevel)
+}
@@ -8,12 +8,22 @@ import (
 	"fmt"
 	"github.com/arangodb/go-driver/v2/arangodb"
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,24 +36,51 @@ func NewDatabaseResource() resource.Resource {
 
 // DatabaseResource defines the resource implementation.
 type DatabaseResource struct {
-	client arangodb.Client
+	client         arangodb.Client
+	defaultTimeout time.Duration
 }
 
 // DatabaseResourceModel describes the resource data model.
 type DatabaseResourceModel struct {
-	Name types.String `tfsdk:"name"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool     `tfsdk:"force_destroy"`
+	Name               types.String   `tfsdk:"name"`
+	ReplicationFactor  types.Int64    `tfsdk:"replication_factor"`
+	Sharding           types.String   `tfsdk:"sharding"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Users              types.List     `tfsdk:"users"`
+	WriteConcern       types.Int64    `tfsdk:"write_concern"`
+}
+
+// DatabaseUserModel describes a user created together with the database.
+type DatabaseUserModel struct {
+	Active   types.Bool   `tfsdk:"active"`
+	Password types.String `tfsdk:"password"`
+	Username types.String `tfsdk:"username"`
 }
 
 func (r *DatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_database"
 }
 
-func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "An Arango Database can store data",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the database, defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the database is destroyed even though it still contains collections, defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				PlanModifiers: []planmodifier.String{
@@ -51,6 +88,76 @@ func (r *DatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 				Required: true,
 			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "Default number of copies kept of each shard of new collections in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sharding": schema.StringAttribute{
+				MarkdownDescription: "Sharding method of new collections in a cluster, can be 'flexible' or 'single', cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("flexible", string(arangodb.DatabaseShardingSingle)),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Users granted access to the database when it is created, changes recreate the database",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user can log in, defaults to true",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "Password of the user, defaults to an empty password",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Login name of the user",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"write_concern": schema.Int64Attribute{
+				MarkdownDescription: "Default number of in-sync copies required before a shard of new collections accepts writes in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -61,18 +168,19 @@ func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*arangodb.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected arangodb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = *client
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
 }
 
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -85,7 +193,24 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	_, err := r.client.CreateDatabase(ctx, plan.Name.ValueString(), &arangodb.CreateDatabaseOptions{})
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	options, diags := toCreateDatabaseOptions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.CreateDatabase(ctx, plan.Name.ValueString(), options)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Resource",
@@ -97,6 +222,20 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	info, err := database.Info(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the created resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	fromDatabaseInfo(&plan, info)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -111,7 +250,22 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	_, err := r.client.GetDatabase(ctx, state.Name.ValueString(), &arangodb.GetDatabaseOptions{SkipExistCheck: false})
+	readTimeout, diags := state.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, state.Name.ValueString(), &arangodb.GetDatabaseOptions{SkipExistCheck: false})
+
+	var info arangodb.DatabaseInfo
+	if err == nil {
+		info, err = database.Info(ctx)
+	}
 
 	if err != nil {
 		if shared.IsNotFound(err) {
@@ -127,6 +281,8 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	fromDatabaseInfo(&state, info)
+
 	// Save updated state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -136,6 +292,29 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatabaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only deletion_protection and force_destroy can change in place, they are not stored on the server.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -148,6 +327,26 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Database Deletion Protected",
+			fmt.Sprintf("The database %q has deletion_protection enabled. "+
+				"Set deletion_protection to false and apply before destroying the database.", data.Name.ValueString()),
+		)
+
+		return
+	}
+
 	database, errGetDatabase := r.client.GetDatabase(ctx, data.Name.ValueString(), &arangodb.GetDatabaseOptions{
 		SkipExistCheck: true,
 	})
@@ -163,6 +362,40 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !data.ForceDestroy.ValueBool() {
+		collections, errCollections := database.Collections(ctx)
+
+		if errCollections != nil && !shared.IsNotFound(errCollections) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to list the collections of the database. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+errCollections.Error(),
+			)
+
+			return
+		}
+
+		var names []string
+
+		for _, collection := range collections {
+			if !strings.HasPrefix(collection.Name(), "_") {
+				names = append(names, collection.Name())
+			}
+		}
+
+		if len(names) > 0 {
+			resp.Diagnostics.AddError(
+				"Database Not Empty",
+				fmt.Sprintf("The database %q still contains the collections: %s. "+
+					"Remove the collections or set force_destroy to true and apply before destroying the database.",
+					data.Name.ValueString(), strings.Join(names, ", ")),
+			)
+
+			return
+		}
+	}
+
 	errRemove := database.Remove(ctx)
 
 	if errRemove != nil && !shared.IsNotFound(errRemove) {
@@ -179,4 +412,66 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+}
+
+func toCreateDatabaseOptions(ctx context.Context, data DatabaseResourceModel) (*arangodb.CreateDatabaseOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := &arangodb.CreateDatabaseOptions{}
+
+	if !data.ReplicationFactor.IsNull() && !data.ReplicationFactor.IsUnknown() {
+		options.Options.ReplicationFactor = arangodb.ReplicationFactor(data.ReplicationFactor.ValueInt64())
+	}
+
+	if !data.WriteConcern.IsNull() && !data.WriteConcern.IsUnknown() {
+		options.Options.WriteConcern = int(data.WriteConcern.ValueInt64())
+	}
+
+	// Flexible sharding is requested by leaving the sharding method empty.
+	if data.Sharding.ValueString() == string(arangodb.DatabaseShardingSingle) {
+		options.Options.Sharding = arangodb.DatabaseShardingSingle
+	}
+
+	if !data.Users.IsNull() && !data.Users.IsUnknown() {
+		var users []DatabaseUserModel
+
+		diags.Append(data.Users.ElementsAs(ctx, &users, false)...)
+
+		for _, user := range users {
+			options.Users = append(options.Users, arangodb.CreateDatabaseUserOptions{
+				UserName: user.Username.ValueString(),
+				Password: user.Password.ValueString(),
+				Active:   user.Active.ValueBoolPointer(),
+			})
+		}
+	}
+
+	return options, diags
+}
+
+// fromDatabaseInfo copies the cluster options of the database into the model.
+// They are only reported by a cluster, a single server leaves the configured values untouched.
+func fromDatabaseInfo(data *DatabaseResourceModel, info arangodb.DatabaseInfo) {
+	if info.ReplicationFactor > 0 {
+		data.ReplicationFactor = types.Int64Value(int64(info.ReplicationFactor))
+	} else if data.ReplicationFactor.IsUnknown() {
+		data.ReplicationFactor = types.Int64Null()
+	}
+
+	if info.WriteConcern > 0 {
+		data.WriteConcern = types.Int64Value(int64(info.WriteConcern))
+	} else if data.WriteConcern.IsUnknown() {
+		data.WriteConcern = types.Int64Null()
+	}
+
+	if info.Sharding == arangodb.DatabaseShardingSingle {
+		data.Sharding = types.StringValue(string(arangodb.DatabaseShardingSingle))
+	} else if info.ReplicationFactor != 0 {
+		data.Sharding = types.StringValue("flexible")
+	} else if data.Sharding.IsUnknown() {
+		data.Sharding = types.StringNull()
+	}
 }
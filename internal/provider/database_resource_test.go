@@ -4,22 +4,30 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDatabaseResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroyed("two"),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccDatabaseResourceConfig("database_name"),
+				Config: testAccDatabaseResourceConfig("database_name", false, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("arangodb_database.test", "name", "database_name"),
+					resource.TestCheckResourceAttr("arangodb_database.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("arangodb_database.test", "force_destroy", "false"),
+					resource.TestCheckResourceAttr("arangodb_database.test", "replication_factor", "1"),
+					resource.TestCheckResourceAttr("arangodb_database.test", "write_concern", "1"),
 				),
 			},
 			//// ImportState testing
@@ -30,20 +38,90 @@ func TestAccDatabaseResource(t *testing.T) {
 			//},
 			// Update and Read testing
 			{
-				Config: testAccDatabaseResourceConfig("two"),
+				Config: testAccDatabaseResourceConfig("two", false, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("arangodb_database.test", "name", "two"),
 				),
 			},
-			// Delete testing automatically occurs in TestCase
+			// Deletion protection testing
+			{
+				Config: testAccDatabaseResourceConfig("two", true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_database.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccDatabaseResourceConfig("two", true, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Database Deletion Protected"),
+			},
+			// Non-empty database testing, the collection is created outside of Terraform
+			{
+				PreConfig: func() { testAccCreateCollection(t, "two", "unmanaged") },
+				Config:    testAccDatabaseResourceConfig("two", false, false),
+			},
+			{
+				Config:      testAccDatabaseResourceConfig("two", false, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Database Not Empty"),
+			},
+			// Force destroy testing, the database is destroyed at the end of the TestCase
+			{
+				Config: testAccDatabaseResourceConfig("two", false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_database.test", "force_destroy", "true"),
+				),
+			},
 		},
 	})
 }
 
-func testAccDatabaseResourceConfig(name string) string {
+func testAccDatabaseResourceConfig(name string, deletionProtection bool, forceDestroy bool) string {
 	return providerConfig + fmt.Sprintf(`
 resource "arangodb_database" "test" {
-  name = %[1]q
+  name                = %[1]q
+  replication_factor  = 1
+  write_concern       = 1
+  deletion_protection = %[2]t
+  force_destroy       = %[3]t
+}
+`, name, deletionProtection, forceDestroy)
+}
+
+func testAccCreateCollection(t *testing.T, databaseName string, collectionName string) {
+	ctx := context.Background()
+
+	client, err := testAccClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database, err := client.GetDatabase(ctx, databaseName, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := database.CreateCollectionV2(ctx, collectionName, nil); err != nil {
+		t.Fatal(err)
+	}
 }
-`, name)
+
+func testAccCheckDatabaseDestroyed(name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		exists, err := client.DatabaseExists(context.Background(), name)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("database %q still exists", name)
+		}
+
+		return nil
+	}
 }
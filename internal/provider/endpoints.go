@@ -0,0 +1,132 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// failoverTransport sends a request to the other endpoints when the endpoint chosen by the driver cannot be reached.
+// Only connection failures are retried, the request has not been received by the server in that case.
+type failoverTransport struct {
+	transport http.RoundTripper
+
+	lock      sync.RWMutex
+	endpoints []*url.URL
+}
+
+func newFailoverTransport(transport http.RoundTripper, endpoints []string) (*failoverTransport, error) {
+	t := &failoverTransport{
+		transport: transport,
+	}
+
+	return t, t.SetEndpoints(endpoints)
+}
+
+// SetEndpoints replaces the endpoints requests fail over to.
+func (t *failoverTransport) SetEndpoints(endpoints []string) error {
+	parsed := make([]*url.URL, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return err
+		}
+
+		parsed = append(parsed, u)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.endpoints = parsed
+
+	return nil
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err == nil || !isConnectionError(err) || req.Context().Err() != nil {
+		return resp, err
+	}
+
+	t.lock.RLock()
+	endpoints := t.endpoints
+	t.lock.RUnlock()
+
+	for _, endpoint := range endpoints {
+		if endpoint.Scheme == req.URL.Scheme && endpoint.Host == req.URL.Host {
+			continue
+		}
+
+		retry := req.Clone(req.Context())
+		retry.URL.Scheme = endpoint.Scheme
+		retry.URL.Host = endpoint.Host
+		retry.Host = ""
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, err
+			}
+
+			retry.Body = body
+		} else if req.Body != nil && req.Body != http.NoBody {
+			return nil, err
+		}
+
+		resp, err = t.transport.RoundTrip(retry)
+		if err == nil || !isConnectionError(err) {
+			return resp, err
+		}
+	}
+
+	return nil, err
+}
+
+// isConnectionError reports whether the request failed before a connection to the server was established.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// discoverEndpoints returns the endpoints of all coordinators of the cluster.
+func discoverEndpoints(ctx context.Context, conn connection.Connection) ([]string, error) {
+	var response struct {
+		shared.ResponseStruct `json:",inline"`
+		Endpoints             []struct {
+			Endpoint string `json:"endpoint"`
+		} `json:"endpoints"`
+	}
+
+	resp, err := connection.CallGet(ctx, conn, connection.NewUrl("_api", "cluster", "endpoints"), &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code() != http.StatusOK {
+		return nil, response.AsArangoErrorWithCode(resp.Code())
+	}
+
+	endpoints := make([]string, 0, len(response.Endpoints))
+
+	for _, endpoint := range response.Endpoints {
+		endpoints = append(endpoints, connection.FixupEndpointURLScheme(endpoint.Endpoint))
+	}
+
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("the server did not report any coordinator endpoints")
+	}
+
+	return endpoints, nil
+}
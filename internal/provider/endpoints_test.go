@@ -0,0 +1,56 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFailoverTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	// Reserve a local address nobody listens on.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unreachable := "http://" + listener.Addr().String()
+	_ = listener.Close()
+
+	transport, err := newFailoverTransport(http.DefaultTransport, []string{unreachable, server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Post(unreachable+"/_api/version", "application/json", strings.NewReader(`{"details":true}`))
+	if err != nil {
+		t.Fatalf("expected the request to fail over, got: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"details":true}` {
+		t.Errorf("expected the request body to be sent again, got: %q", body)
+	}
+
+	if err := transport.SetEndpoints([]string{unreachable}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Get(unreachable + "/_api/version"); err == nil {
+		t.Error("expected an error when no endpoint can be reached")
+	}
+}
@@ -0,0 +1,832 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphResource{}
+var _ resource.ResourceWithImportState = &GraphResource{}
+var _ resource.ResourceWithModifyPlan = &GraphResource{}
+
+func NewGraphResource() resource.Resource {
+	return &GraphResource{}
+}
+
+// GraphResource defines the resource implementation.
+type GraphResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+	server         *ServerInfo
+}
+
+// GraphResourceModel describes the resource data model.
+type GraphResourceModel struct {
+	Database            types.String   `tfsdk:"database"`
+	Disjoint            types.Bool     `tfsdk:"disjoint"`
+	DropCollections     types.Bool     `tfsdk:"drop_collections"`
+	EdgeDefinitions     types.Set      `tfsdk:"edge_definitions"`
+	Name                types.String   `tfsdk:"name"`
+	NumberOfShards      types.Int64    `tfsdk:"number_of_shards"`
+	OrphanCollections   types.Set      `tfsdk:"orphan_collections"`
+	ReplicationFactor   types.Int64    `tfsdk:"replication_factor"`
+	Satellite           types.Bool     `tfsdk:"satellite"`
+	Satellites          types.Set      `tfsdk:"satellites"`
+	Smart               types.Bool     `tfsdk:"smart"`
+	SmartGraphAttribute types.String   `tfsdk:"smart_graph_attribute"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	WriteConcern        types.Int64    `tfsdk:"write_concern"`
+}
+
+// GraphEdgeDefinitionModel describes the relation stored in an edge collection.
+type GraphEdgeDefinitionModel struct {
+	Collection types.String `tfsdk:"collection"`
+	From       types.Set    `tfsdk:"from"`
+	To         types.Set    `tfsdk:"to"`
+}
+
+var graphEdgeDefinitionAttrTypes = map[string]attr.Type{
+	"collection": types.StringType,
+	"from":       types.SetType{ElemType: types.StringType},
+	"to":         types.SetType{ElemType: types.StringType},
+}
+
+func (r *GraphResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph"
+}
+
+func (r *GraphResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An Arango named graph defines which collections hold the vertices and edges of a graph",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"disjoint": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SmartGraph is disjoint, defaults to false. Enterprise Edition only, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"drop_collections": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collections of the graph are dropped when the graph is destroyed, defaults to false. " +
+					"Collections used by other graphs are kept",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"edge_definitions": schema.SetNestedAttribute{
+				MarkdownDescription: "Edge collections of the graph with the vertex collections they connect, changes are applied in place",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collection": schema.StringAttribute{
+							MarkdownDescription: "Name of the edge collection",
+							Required:            true,
+						},
+						"from": schema.SetAttribute{
+							MarkdownDescription: "Vertex collections allowed in the `_from` attribute of the edges",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+						"to": schema.SetAttribute{
+							MarkdownDescription: "Vertex collections allowed in the `_to` attribute of the edges",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Graph name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"number_of_shards": schema.Int64Attribute{
+				MarkdownDescription: "Number of shards of the collections created for the graph in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"orphan_collections": schema.SetAttribute{
+				MarkdownDescription: "Vertex collections of the graph which are not used in any edge definition, changes are applied in place",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "Number of copies kept of each shard of the collections created for the graph in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"satellite": schema.BoolAttribute{
+				MarkdownDescription: "Whether the graph is a SatelliteGraph replicated to every server, defaults to false. Enterprise Edition only, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"satellites": schema.SetAttribute{
+				MarkdownDescription: "Vertex collections created as satellite collections of a hybrid SmartGraph. Enterprise Edition only, cannot be changed after creation",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"smart": schema.BoolAttribute{
+				MarkdownDescription: "Whether the graph is a SmartGraph, defaults to false. Enterprise Edition only, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"smart_graph_attribute": schema.StringAttribute{
+				MarkdownDescription: "Document attribute used to shard the vertices of a SmartGraph, cannot be changed after creation",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"write_concern": schema.Int64Attribute{
+				MarkdownDescription: "Number of in-sync copies required before a shard accepts writes in a cluster, cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *GraphResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+	r.server = providerData.Server
+}
+
+func (r *GraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data GraphResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enterprise := []struct {
+		name  string
+		isSet bool
+	}{
+		{"smart", data.Smart.ValueBool()},
+		{"satellite", data.Satellite.ValueBool()},
+		{"disjoint", data.Disjoint.ValueBool()},
+		{"smart_graph_attribute", !data.SmartGraphAttribute.IsNull()},
+		{"satellites", len(data.Satellites.Elements()) > 0},
+	}
+
+	for _, attribute := range enterprise {
+		if attribute.isSet {
+			resp.Diagnostics.Append(r.server.Require(path.Root(attribute.name), serverRequirement{
+				feature:    "SmartGraphs and SatelliteGraphs",
+				enterprise: true,
+			})...)
+		}
+	}
+}
+
+func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	definition, options, diags := toGraphDefinition(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	graph, err := database.CreateGraph(ctx, data.Name.ValueString(), definition, options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromGraph(ctx, &data, graph)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Database",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	graph, err := database.Graph(ctx, data.Name.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(fromGraph(ctx, &data, graph)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state GraphResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	planned, diags := toEdgeDefinitions(ctx, data.EdgeDefinitions)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := toEdgeDefinitions(ctx, state.EdgeDefinitions)
+	resp.Diagnostics.Append(diags...)
+
+	var orphans []string
+
+	if !data.OrphanCollections.IsNull() && !data.OrphanCollections.IsUnknown() {
+		resp.Diagnostics.Append(data.OrphanCollections.ElementsAs(ctx, &orphans, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	graph, err := database.Graph(ctx, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Graph",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Edge definitions are changed one by one so that the graph and its collections are kept.
+	err = updateEdgeDefinitions(ctx, graph, current, planned)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the edge definitions. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Removing or adding edge definitions moves vertex collections in and out of the
+	// orphan collections on the server, compare against the updated graph.
+	graph, err = database.Graph(ctx, data.Name.ValueString(), nil)
+	if err == nil {
+		err = updateOrphanCollections(ctx, graph, orphans)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the orphan collections. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	graph, err = database.Graph(ctx, data.Name.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the updated resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromGraph(ctx, &data, graph)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	graph, err := database.Graph(ctx, data.Name.ValueString(), &arangodb.GetGraphOptions{
+		SkipExistCheck: true,
+	})
+	if err == nil {
+		err = graph.Remove(ctx, &arangodb.RemoveGraphOptions{
+			DropCollections: data.DropCollections.ValueBool(),
+		})
+	}
+
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *GraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/graph. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("drop_collections"), false)...)
+}
+
+func toGraphDefinition(ctx context.Context, data GraphResourceModel) (*arangodb.GraphDefinition, *arangodb.CreateGraphOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definition := &arangodb.GraphDefinition{
+		IsSmart:             data.Smart.ValueBool(),
+		IsSatellite:         data.Satellite.ValueBool(),
+		IsDisjoint:          data.Disjoint.ValueBool(),
+		SmartGraphAttribute: data.SmartGraphAttribute.ValueString(),
+	}
+
+	edgeDefinitions, edgeDefinitionsDiags := toEdgeDefinitions(ctx, data.EdgeDefinitions)
+	diags.Append(edgeDefinitionsDiags...)
+	definition.EdgeDefinitions = edgeDefinitions
+
+	if !data.OrphanCollections.IsNull() && !data.OrphanCollections.IsUnknown() {
+		diags.Append(data.OrphanCollections.ElementsAs(ctx, &definition.OrphanCollections, false)...)
+	}
+
+	if !data.NumberOfShards.IsNull() && !data.NumberOfShards.IsUnknown() {
+		numberOfShards := int(data.NumberOfShards.ValueInt64())
+		definition.NumberOfShards = &numberOfShards
+	}
+
+	if !data.WriteConcern.IsNull() && !data.WriteConcern.IsUnknown() {
+		writeConcern := int(data.WriteConcern.ValueInt64())
+		definition.WriteConcern = &writeConcern
+	}
+
+	// The replication factor type of the graph definition is not exported by the driver,
+	// it is set by decoding the value the same way as a graph returned by the server.
+	if data.Satellite.ValueBool() {
+		definition.ReplicationFactor = arangodb.SatelliteGraph
+	} else if !data.ReplicationFactor.IsNull() && !data.ReplicationFactor.IsUnknown() {
+		replicationFactor, err := json.Marshal(map[string]int64{"replicationFactor": data.ReplicationFactor.ValueInt64()})
+
+		if err == nil {
+			err = json.Unmarshal(replicationFactor, definition)
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("replication_factor"),
+				"Invalid Replication Factor",
+				"The replication factor could not be set: "+err.Error(),
+			)
+		}
+	}
+
+	options := &arangodb.CreateGraphOptions{}
+
+	if !data.Satellites.IsNull() && !data.Satellites.IsUnknown() {
+		diags.Append(data.Satellites.ElementsAs(ctx, &options.Satellites, false)...)
+	}
+
+	return definition, options, diags
+}
+
+func toEdgeDefinitions(ctx context.Context, set types.Set) ([]arangodb.EdgeDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []GraphEdgeDefinitionModel
+
+	edgeDefinitions := []arangodb.EdgeDefinition{}
+
+	if set.IsNull() || set.IsUnknown() {
+		return edgeDefinitions, diags
+	}
+
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	for _, model := range models {
+		edgeDefinition := arangodb.EdgeDefinition{
+			Collection: model.Collection.ValueString(),
+		}
+
+		diags.Append(model.From.ElementsAs(ctx, &edgeDefinition.From, false)...)
+		diags.Append(model.To.ElementsAs(ctx, &edgeDefinition.To, false)...)
+
+		edgeDefinitions = append(edgeDefinitions, edgeDefinition)
+	}
+
+	return edgeDefinitions, diags
+}
+
+// updateEdgeDefinitions removes, replaces and adds the edge definitions which differ between current and planned.
+// The edge collections themselves are never dropped.
+func updateEdgeDefinitions(ctx context.Context, graph arangodb.Graph, current []arangodb.EdgeDefinition, planned []arangodb.EdgeDefinition) error {
+	dropCollection := false
+
+	find := func(edgeDefinitions []arangodb.EdgeDefinition, collection string) (arangodb.EdgeDefinition, bool) {
+		for _, edgeDefinition := range edgeDefinitions {
+			if edgeDefinition.Collection == collection {
+				return edgeDefinition, true
+			}
+		}
+
+		return arangodb.EdgeDefinition{}, false
+	}
+
+	for _, edgeDefinition := range current {
+		if _, ok := find(planned, edgeDefinition.Collection); !ok {
+			_, err := graph.DeleteEdgeDefinition(ctx, edgeDefinition.Collection, &arangodb.DeleteEdgeDefinitionOptions{
+				DropCollection: &dropCollection,
+			})
+			if err != nil && !shared.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	for _, edgeDefinition := range planned {
+		existing, ok := find(current, edgeDefinition.Collection)
+
+		if !ok {
+			_, err := graph.CreateEdgeDefinition(ctx, edgeDefinition.Collection, edgeDefinition.From, edgeDefinition.To, nil)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !sameElements(existing.From, edgeDefinition.From) || !sameElements(existing.To, edgeDefinition.To) {
+			_, err := graph.ReplaceEdgeDefinition(ctx, edgeDefinition.Collection, edgeDefinition.From, edgeDefinition.To, &arangodb.ReplaceEdgeOptions{
+				DropCollection: &dropCollection,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateOrphanCollections adds and removes orphan collections of the graph to match the planned ones.
+func updateOrphanCollections(ctx context.Context, graph arangodb.Graph, planned []string) error {
+	dropCollection := false
+	current := graph.OrphanCollections()
+
+	for _, collection := range current {
+		if !slices.Contains(planned, collection) {
+			_, err := graph.DeleteVertexCollection(ctx, collection, &arangodb.DeleteVertexCollectionOptions{
+				DropCollection: &dropCollection,
+			})
+			if err != nil && !shared.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	for _, collection := range planned {
+		if !slices.Contains(current, collection) {
+			_, err := graph.CreateVertexCollection(ctx, collection, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// fromGraph copies the server side graph definition into the model.
+// Cluster only properties are left untouched when the server does not report them.
+func fromGraph(ctx context.Context, data *GraphResourceModel, graph arangodb.Graph) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(graph.Name())
+	data.Smart = types.BoolValue(graph.IsSmart())
+	data.Satellite = types.BoolValue(graph.IsSatellite())
+	data.Disjoint = types.BoolValue(graph.IsDisjoint())
+
+	if graph.SmartGraphAttribute() != "" {
+		data.SmartGraphAttribute = types.StringValue(graph.SmartGraphAttribute())
+	}
+
+	if graph.NumberOfShards() != nil {
+		data.NumberOfShards = types.Int64Value(int64(*graph.NumberOfShards()))
+	} else if data.NumberOfShards.IsUnknown() {
+		data.NumberOfShards = types.Int64Null()
+	}
+
+	if graph.ReplicationFactor() > 0 {
+		data.ReplicationFactor = types.Int64Value(int64(graph.ReplicationFactor()))
+	} else if data.ReplicationFactor.IsUnknown() {
+		data.ReplicationFactor = types.Int64Null()
+	}
+
+	if graph.WriteConcern() != nil {
+		data.WriteConcern = types.Int64Value(int64(*graph.WriteConcern()))
+	} else if data.WriteConcern.IsUnknown() {
+		data.WriteConcern = types.Int64Null()
+	}
+
+	if orphans := graph.OrphanCollections(); len(orphans) > 0 {
+		orphansValue, orphansDiags := types.SetValueFrom(ctx, types.StringType, orphans)
+		diags.Append(orphansDiags...)
+		data.OrphanCollections = orphansValue
+	} else if data.OrphanCollections.IsNull() || data.OrphanCollections.IsUnknown() {
+		data.OrphanCollections = types.SetNull(types.StringType)
+	} else {
+		data.OrphanCollections = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+
+	edgeDefinitionType := types.ObjectType{AttrTypes: graphEdgeDefinitionAttrTypes}
+
+	if len(graph.EdgeDefinitions()) == 0 {
+		if data.EdgeDefinitions.IsNull() || data.EdgeDefinitions.IsUnknown() {
+			data.EdgeDefinitions = types.SetNull(edgeDefinitionType)
+		} else {
+			data.EdgeDefinitions = types.SetValueMust(edgeDefinitionType, []attr.Value{})
+		}
+
+		return diags
+	}
+
+	edgeDefinitions := make([]GraphEdgeDefinitionModel, 0, len(graph.EdgeDefinitions()))
+
+	for _, edgeDefinition := range graph.EdgeDefinitions() {
+		from, fromDiags := types.SetValueFrom(ctx, types.StringType, edgeDefinition.From)
+		diags.Append(fromDiags...)
+
+		to, toDiags := types.SetValueFrom(ctx, types.StringType, edgeDefinition.To)
+		diags.Append(toDiags...)
+
+		edgeDefinitions = append(edgeDefinitions, GraphEdgeDefinitionModel{
+			Collection: types.StringValue(edgeDefinition.Collection),
+			From:       from,
+			To:         to,
+		})
+	}
+
+	edgeDefinitionsValue, edgeDefinitionsDiags := types.SetValueFrom(ctx, edgeDefinitionType, edgeDefinitions)
+	diags.Append(edgeDefinitionsDiags...)
+	data.EdgeDefinitions = edgeDefinitionsValue
+
+	return diags
+}
+
+// sameElements reports whether both slices contain the same strings regardless of their order.
+func sameElements(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphResourceConfig("graph_database", "graph", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_graph.test", "name", "graph"),
+					resource.TestCheckResourceAttr("arangodb_graph.test", "edge_definitions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("arangodb_graph.test", "edge_definitions.*", map[string]string{
+						"collection": "knows",
+						"from.#":     "1",
+						"to.#":       "1",
+					}),
+					resource.TestCheckNoResourceAttr("arangodb_graph.test", "orphan_collections"),
+					resource.TestCheckResourceAttr("arangodb_graph.test", "smart", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "arangodb_graph.test",
+				ImportState:                          true,
+				ImportStateId:                        "graph_database/graph",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"drop_collections"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphResourceConfig("graph_database", "graph", `
+  edge_definitions = [
+    {
+      collection = "knows"
+      from       = ["people"]
+      to         = ["people", "places"]
+    },
+    {
+      collection = "visited"
+      from       = ["people"]
+      to         = ["places"]
+    },
+  ]
+
+  orphan_collections = ["things"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_graph.test", "edge_definitions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("arangodb_graph.test", "edge_definitions.*", map[string]string{
+						"collection": "knows",
+						"to.#":       "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("arangodb_graph.test", "edge_definitions.*", map[string]string{
+						"collection": "visited",
+					}),
+					resource.TestCheckResourceAttr("arangodb_graph.test", "orphan_collections.#", "1"),
+					resource.TestCheckTypeSetElemAttr("arangodb_graph.test", "orphan_collections.*", "things"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGraphResourceConfig(databaseName string, name string, definitions string) string {
+	if definitions == "" {
+		definitions = `
+  edge_definitions = [
+    {
+      collection = "knows"
+      from       = ["people"]
+      to         = ["people"]
+    },
+  ]`
+	}
+
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_graph" "test" {
+  database         = arangodb_database.test.name
+  name             = %[2]q
+  drop_collections = true
+%[3]s
+}
+`, databaseName, name, definitions)
+}
+
+func TestToGraphDefinitionReplicationFactor(t *testing.T) {
+	data := GraphResourceModel{
+		ReplicationFactor: types.Int64Value(3),
+	}
+
+	definition, _, diags := toGraphDefinition(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	body, err := json.Marshal(definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(body), `"replicationFactor":3`) {
+		t.Errorf("expected a replication factor of 3, got: %s", body)
+	}
+}
@@ -0,0 +1,909 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IndexResource{}
+var _ resource.ResourceWithImportState = &IndexResource{}
+var _ resource.ResourceWithModifyPlan = &IndexResource{}
+var _ resource.ResourceWithValidateConfig = &IndexResource{}
+
+func NewIndexResource() resource.Resource {
+	return &IndexResource{}
+}
+
+// IndexResource defines the resource implementation.
+type IndexResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+	server         *ServerInfo
+}
+
+// IndexResourceModel describes the resource data model.
+type IndexResourceModel struct {
+	Analyzer           types.String   `tfsdk:"analyzer"`
+	Cache              types.Bool     `tfsdk:"cache"`
+	CacheEnabled       types.Bool     `tfsdk:"cache_enabled"`
+	Collection         types.String   `tfsdk:"collection"`
+	Database           types.String   `tfsdk:"database"`
+	Deduplicate        types.Bool     `tfsdk:"deduplicate"`
+	Estimates          types.Bool     `tfsdk:"estimates"`
+	ExpireAfter        types.Int64    `tfsdk:"expire_after"`
+	FieldValueTypes    types.String   `tfsdk:"field_value_types"`
+	Features           types.Set      `tfsdk:"features"`
+	Fields             types.List     `tfsdk:"fields"`
+	GeoJson            types.Bool     `tfsdk:"geo_json"`
+	Id                 types.String   `tfsdk:"id"`
+	InBackground       types.Bool     `tfsdk:"in_background"`
+	IncludeAllFields   types.Bool     `tfsdk:"include_all_fields"`
+	LegacyPolygons     types.Bool     `tfsdk:"legacy_polygons"`
+	Name               types.String   `tfsdk:"name"`
+	PrefixFields       types.List     `tfsdk:"prefix_fields"`
+	SearchField        types.Bool     `tfsdk:"search_field"`
+	Sparse             types.Bool     `tfsdk:"sparse"`
+	StoredValues       types.List     `tfsdk:"stored_values"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	TrackListPositions types.Bool     `tfsdk:"track_list_positions"`
+	Type               types.String   `tfsdk:"type"`
+	Unique             types.Bool     `tfsdk:"unique"`
+}
+
+// indexTypeAttributes lists the type specific attributes accepted by each index type.
+var indexTypeAttributes = map[arangodb.IndexType][]string{
+	arangodb.PersistentIndexType:  {"cache_enabled", "deduplicate", "estimates", "sparse", "stored_values", "unique"},
+	arangodb.TTLIndexType:         {"expire_after"},
+	arangodb.GeoIndexType:         {"geo_json", "legacy_polygons"},
+	arangodb.MDIIndexType:         {"field_value_types", "sparse", "stored_values", "unique"},
+	arangodb.MDIPrefixedIndexType: {"field_value_types", "prefix_fields", "sparse", "stored_values", "unique"},
+	arangodb.InvertedIndexType:    {"analyzer", "cache", "features", "include_all_fields", "search_field", "track_list_positions"},
+}
+
+// indexTypeRequirements lists the index types which are not supported by every server.
+var indexTypeRequirements = map[string]serverRequirement{
+	string(arangodb.InvertedIndexType):    {feature: "Inverted indexes", minVersion: "3.10.0"},
+	string(arangodb.MDIIndexType):         {feature: "Multi-dimensional indexes", minVersion: "3.12.0"},
+	string(arangodb.MDIPrefixedIndexType): {feature: "Prefixed multi-dimensional indexes", minVersion: "3.12.0"},
+}
+
+func (r *IndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index"
+}
+
+func (r *IndexResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An Arango index speeds up queries on a collection. Indexes are immutable, any change to the definition replaces the index",
+
+		Attributes: map[string]schema.Attribute{
+			"analyzer": schema.StringAttribute{
+				MarkdownDescription: "Name of the analyzer applied to the fields, `inverted` indexes only, defaults to 'identity'",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether the field normalization values are cached in memory, `inverted` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"cache_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the in-memory cache for index lookups is enabled, `persistent` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "Collection name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"deduplicate": schema.BoolAttribute{
+				MarkdownDescription: "Whether duplicate array values of a single document are indexed only once, `persistent` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"estimates": schema.BoolAttribute{
+				MarkdownDescription: "Whether selectivity estimates are maintained, `persistent` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"expire_after": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds after the stored point in time at which documents expire, required for `ttl` indexes",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"field_value_types": schema.StringAttribute{
+				MarkdownDescription: "Value type of the indexed fields, `mdi` and `mdi-prefixed` indexes only, defaults to 'double'",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(arangodb.MDIDoubleFieldType)),
+				},
+			},
+			"features": schema.SetAttribute{
+				MarkdownDescription: "ArangoSearch features enabled for the fields, `inverted` indexes only",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(arangodb.ArangoSearchFeatureFrequency),
+						string(arangodb.ArangoSearchFeatureNorm),
+						string(arangodb.ArangoSearchFeaturePosition),
+						string(arangodb.ArangoSearchFeatureOffset),
+					)),
+				},
+			},
+			"fields": schema.ListAttribute{
+				MarkdownDescription: "Document attribute paths covered by the index",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"geo_json": schema.BoolAttribute{
+				MarkdownDescription: "Whether coordinate arrays are in GeoJSON order (longitude first), `geo` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Index identifier within the collection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_background": schema.BoolAttribute{
+				MarkdownDescription: "Whether the index is built in the background without write-locking the collection, only used on creation",
+				Optional:            true,
+			},
+			"include_all_fields": schema.BoolAttribute{
+				MarkdownDescription: "Whether all document attributes are indexed, `inverted` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"legacy_polygons": schema.BoolAttribute{
+				MarkdownDescription: "Whether the legacy polygon semantics of ArangoDB before 3.10 are used, `geo` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Index name, unique within the collection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"prefix_fields": schema.ListAttribute{
+				MarkdownDescription: "Document attributes used as search prefix, required for `mdi-prefixed` indexes",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"search_field": schema.BoolAttribute{
+				MarkdownDescription: "Whether array values are indexed as individual values, `inverted` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sparse": schema.BoolAttribute{
+				MarkdownDescription: "Whether documents without the indexed attributes or with `null` values are excluded, `persistent`, `mdi` and `mdi-prefixed` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"stored_values": schema.ListAttribute{
+				MarkdownDescription: "Additional document attributes stored in the index for projections, `persistent`, `mdi` and `mdi-prefixed` indexes only",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"track_list_positions": schema.BoolAttribute{
+				MarkdownDescription: "Whether the position of values in arrays is tracked, `inverted` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Index type, can be 'persistent', 'ttl', 'geo', 'mdi', 'mdi-prefixed' or 'inverted'. " +
+					"The deprecated 'zkd' type is not supported, use 'mdi' which replaces it since ArangoDB 3.12",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(arangodb.PersistentIndexType),
+						string(arangodb.TTLIndexType),
+						string(arangodb.GeoIndexType),
+						string(arangodb.MDIIndexType),
+						string(arangodb.MDIPrefixedIndexType),
+						string(arangodb.InvertedIndexType),
+					),
+				},
+			},
+			"unique": schema.BoolAttribute{
+				MarkdownDescription: "Whether the indexed values must be unique, `persistent`, `mdi` and `mdi-prefixed` indexes only",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *IndexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	indexType := arangodb.IndexType(data.Type.ValueString())
+	allowed := indexTypeAttributes[indexType]
+
+	for name, value := range indexTypeSpecificValues(data) {
+		if !value.IsNull() && !slices.Contains(allowed, name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The attribute %q is not supported by indexes of type %q.", name, indexType),
+			)
+		}
+	}
+
+	switch indexType {
+	case arangodb.TTLIndexType:
+		if data.ExpireAfter.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expire_after"),
+				"Missing Attribute Configuration",
+				"The attribute \"expire_after\" is required for indexes of type \"ttl\".",
+			)
+		}
+
+		if !data.Fields.IsUnknown() && len(data.Fields.Elements()) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields"),
+				"Invalid Attribute Value",
+				"Indexes of type \"ttl\" must cover exactly one field.",
+			)
+		}
+	case arangodb.GeoIndexType:
+		if !data.Fields.IsUnknown() && len(data.Fields.Elements()) > 2 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields"),
+				"Invalid Attribute Value",
+				"Indexes of type \"geo\" must cover one or two fields.",
+			)
+		}
+	case arangodb.MDIPrefixedIndexType:
+		if data.PrefixFields.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix_fields"),
+				"Missing Attribute Configuration",
+				"The attribute \"prefix_fields\" is required for indexes of type \"mdi-prefixed\".",
+			)
+		}
+	}
+}
+
+func (r *IndexResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+	r.server = providerData.Server
+}
+
+func (r *IndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data IndexResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if requirement, ok := indexTypeRequirements[data.Type.ValueString()]; ok {
+		resp.Diagnostics.Append(r.server.Require(path.Root("type"), requirement)...)
+	}
+}
+
+func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Collection.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Collection",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	index, diags := ensureIndex(ctx, collection, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(fromIndexResponse(ctx, &data, index)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Database",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Collection.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Collection",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	index, err := collection.Index(ctx, data.Id.ValueString())
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(fromIndexResponse(ctx, &data, index)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Every attribute of the index definition requires a replacement, only
+	// in_background can change in place and it has no effect after creation.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IndexResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	collection, err := database.GetCollection(ctx, data.Collection.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	err = collection.DeleteIndex(ctx, data.Id.ValueString())
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *IndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/collection/index_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+// indexTypeSpecificValues returns the attributes which only apply to some index types, keyed by attribute name.
+func indexTypeSpecificValues(data IndexResourceModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"analyzer":             data.Analyzer,
+		"cache":                data.Cache,
+		"cache_enabled":        data.CacheEnabled,
+		"deduplicate":          data.Deduplicate,
+		"estimates":            data.Estimates,
+		"expire_after":         data.ExpireAfter,
+		"features":             data.Features,
+		"field_value_types":    data.FieldValueTypes,
+		"geo_json":             data.GeoJson,
+		"include_all_fields":   data.IncludeAllFields,
+		"legacy_polygons":      data.LegacyPolygons,
+		"prefix_fields":        data.PrefixFields,
+		"search_field":         data.SearchField,
+		"sparse":               data.Sparse,
+		"stored_values":        data.StoredValues,
+		"track_list_positions": data.TrackListPositions,
+		"unique":               data.Unique,
+	}
+}
+
+// ensureIndex creates the index described by the model with the call matching its type.
+func ensureIndex(ctx context.Context, collection arangodb.Collection, data IndexResourceModel) (arangodb.IndexResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var fields, storedValues, prefixFields []string
+	var index arangodb.IndexResponse
+	var created bool
+	var err error
+
+	diags.Append(data.Fields.ElementsAs(ctx, &fields, false)...)
+
+	if !data.StoredValues.IsNull() && !data.StoredValues.IsUnknown() {
+		diags.Append(data.StoredValues.ElementsAs(ctx, &storedValues, false)...)
+	}
+
+	if !data.PrefixFields.IsNull() && !data.PrefixFields.IsUnknown() {
+		diags.Append(data.PrefixFields.ElementsAs(ctx, &prefixFields, false)...)
+	}
+
+	if diags.HasError() {
+		return index, diags
+	}
+
+	name := data.Name.ValueString()
+	inBackground := data.InBackground.ValueBoolPointer()
+
+	mdiOptions := arangodb.CreateMDIIndexOptions{
+		Name:            name,
+		FieldValueTypes: arangodb.MDIDoubleFieldType,
+		Unique:          data.Unique.ValueBoolPointer(),
+		Sparse:          data.Sparse.ValueBoolPointer(),
+		InBackground:    inBackground,
+		StoredValues:    storedValues,
+	}
+
+	if !data.FieldValueTypes.IsNull() && !data.FieldValueTypes.IsUnknown() {
+		mdiOptions.FieldValueTypes = arangodb.MDIFieldType(data.FieldValueTypes.ValueString())
+	}
+
+	switch arangodb.IndexType(data.Type.ValueString()) {
+	case arangodb.PersistentIndexType:
+		index, created, err = collection.EnsurePersistentIndex(ctx, fields, &arangodb.CreatePersistentIndexOptions{
+			Name:         name,
+			CacheEnabled: data.CacheEnabled.ValueBoolPointer(),
+			StoredValues: storedValues,
+			Sparse:       data.Sparse.ValueBoolPointer(),
+			Unique:       data.Unique.ValueBoolPointer(),
+			Deduplicate:  data.Deduplicate.ValueBoolPointer(),
+			Estimates:    data.Estimates.ValueBoolPointer(),
+			InBackground: inBackground,
+		})
+	case arangodb.TTLIndexType:
+		index, created, err = collection.EnsureTTLIndex(ctx, fields, int(data.ExpireAfter.ValueInt64()), &arangodb.CreateTTLIndexOptions{
+			Name:         name,
+			InBackground: inBackground,
+		})
+	case arangodb.GeoIndexType:
+		index, created, err = collection.EnsureGeoIndex(ctx, fields, &arangodb.CreateGeoIndexOptions{
+			Name:           name,
+			GeoJSON:        data.GeoJson.ValueBoolPointer(),
+			LegacyPolygons: data.LegacyPolygons.ValueBoolPointer(),
+			InBackground:   inBackground,
+		})
+	case arangodb.MDIIndexType:
+		index, created, err = collection.EnsureMDIIndex(ctx, fields, &mdiOptions)
+	case arangodb.MDIPrefixedIndexType:
+		index, created, err = collection.EnsureMDIPrefixedIndex(ctx, fields, &arangodb.CreateMDIPrefixedIndexOptions{
+			CreateMDIIndexOptions: mdiOptions,
+			PrefixFields:          prefixFields,
+		})
+	case arangodb.InvertedIndexType:
+		var features []string
+
+		if !data.Features.IsNull() && !data.Features.IsUnknown() {
+			diags.Append(data.Features.ElementsAs(ctx, &features, false)...)
+		}
+
+		options := &arangodb.InvertedIndexOptions{
+			Name:               name,
+			SearchField:        data.SearchField.ValueBoolPointer(),
+			Cache:              data.Cache.ValueBoolPointer(),
+			Analyzer:           data.Analyzer.ValueString(),
+			IncludeAllFields:   data.IncludeAllFields.ValueBoolPointer(),
+			TrackListPositions: data.TrackListPositions.ValueBool(),
+			InBackground:       inBackground,
+		}
+
+		for _, field := range fields {
+			options.Fields = append(options.Fields, arangodb.InvertedIndexField{Name: field})
+		}
+
+		for _, feature := range features {
+			options.Features = append(options.Features, arangodb.ArangoSearchFeature(feature))
+		}
+
+		index, created, err = collection.EnsureInvertedIndex(ctx, options)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+	} else if !created {
+		// The server returns an existing index with the same definition instead of creating one,
+		// managing it would delete an index Terraform did not create.
+		diags.AddError(
+			"Index Already Exists",
+			fmt.Sprintf("An index with the same definition already exists on collection %q as %q, import it instead with the identifier %s/%s/%s.",
+				data.Collection.ValueString(), index.Name, data.Database.ValueString(), data.Collection.ValueString(), indexId(index)),
+		)
+	}
+
+	return index, diags
+}
+
+// indexId returns the identifier of the index within its collection, the server reports it as collection/id.
+func indexId(index arangodb.IndexResponse) string {
+	return index.ID[strings.LastIndex(index.ID, "/")+1:]
+}
+
+// fromIndexResponse copies the server side index definition into the model.
+// Attributes the server does not report for the index type are kept as configured.
+func fromIndexResponse(ctx context.Context, data *IndexResourceModel, index arangodb.IndexResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(indexId(index))
+	data.Name = types.StringValue(index.Name)
+	data.Type = types.StringValue(string(index.Type))
+	data.Sparse = fromBoolPointer(data.Sparse, index.Sparse)
+	data.Unique = fromBoolPointer(data.Unique, index.Unique)
+
+	var fields []string
+
+	if index.RegularIndex != nil {
+		options := index.RegularIndex
+		fields = options.Fields
+
+		data.CacheEnabled = fromBoolPointer(data.CacheEnabled, options.CacheEnabled)
+		data.Deduplicate = fromBoolPointer(data.Deduplicate, options.Deduplicate)
+		data.Estimates = fromBoolPointer(data.Estimates, options.Estimates)
+		data.GeoJson = fromBoolPointer(data.GeoJson, options.GeoJSON)
+		data.LegacyPolygons = fromBoolPointer(data.LegacyPolygons, options.LegacyPolygons)
+
+		if options.ExpireAfter != nil {
+			data.ExpireAfter = types.Int64Value(int64(*options.ExpireAfter))
+		}
+
+		if len(options.StoredValues) > 0 {
+			storedValues, storedValuesDiags := types.ListValueFrom(ctx, types.StringType, options.StoredValues)
+			diags.Append(storedValuesDiags...)
+			data.StoredValues = storedValues
+		}
+	}
+
+	if index.InvertedIndex != nil {
+		options := index.InvertedIndex
+
+		for _, field := range options.Fields {
+			fields = append(fields, field.Name)
+		}
+
+		data.Analyzer = types.StringValue(options.Analyzer)
+		data.Cache = fromBoolPointer(data.Cache, options.Cache)
+		data.IncludeAllFields = fromBoolPointer(data.IncludeAllFields, options.IncludeAllFields)
+		data.SearchField = fromBoolPointer(data.SearchField, options.SearchField)
+		data.TrackListPositions = types.BoolValue(options.TrackListPositions)
+
+		features := make([]string, 0, len(options.Features))
+		for _, feature := range options.Features {
+			features = append(features, string(feature))
+		}
+
+		featuresValue, featuresDiags := types.SetValueFrom(ctx, types.StringType, features)
+		diags.Append(featuresDiags...)
+		data.Features = featuresValue
+	}
+
+	fieldsValue, fieldsDiags := types.ListValueFrom(ctx, types.StringType, fields)
+	diags.Append(fieldsDiags...)
+	data.Fields = fieldsValue
+
+	// Attributes which do not apply to the index type are unknown after planning.
+	if data.Analyzer.IsUnknown() {
+		data.Analyzer = types.StringNull()
+	}
+
+	if data.Features.IsUnknown() {
+		data.Features = types.SetNull(types.StringType)
+	}
+
+	if data.TrackListPositions.IsUnknown() {
+		data.TrackListPositions = types.BoolNull()
+	}
+
+	return diags
+}
+
+// fromBoolPointer returns the server side value when reported, otherwise the current value with unknown turned into null.
+func fromBoolPointer(current types.Bool, value *bool) types.Bool {
+	if value != nil {
+		return types.BoolValue(*value)
+	}
+
+	if current.IsUnknown() {
+		return types.BoolNull()
+	}
+
+	return current
+}
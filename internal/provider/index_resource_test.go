@@ -0,0 +1,124 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIndexResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIndexResourceConfig("index_database", "index_collection", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "type", "persistent"),
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "name", "by_email"),
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "unique", "false"),
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "fields.#", "1"),
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "stored_values.0", "name"),
+					resource.TestCheckResourceAttrSet("arangodb_index.persistent", "id"),
+					resource.TestCheckResourceAttr("arangodb_index.ttl", "type", "ttl"),
+					resource.TestCheckResourceAttr("arangodb_index.ttl", "expire_after", "3600"),
+					resource.TestCheckResourceAttr("arangodb_index.geo", "geo_json", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "arangodb_index.persistent",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIndexImportStateIdFunc("arangodb_index.persistent"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"in_background", "timeouts"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccIndexResourceConfig("index_database", "index_collection", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_index.persistent", "unique", "true"),
+				),
+			},
+			// Existing index testing
+			{
+				Config: testAccIndexResourceConfig("index_database", "index_collection", true) + `
+resource "arangodb_index" "duplicate" {
+  database     = arangodb_index.ttl.database
+  collection   = arangodb_index.ttl.collection
+  name         = "expiry"
+  type         = "ttl"
+  fields       = ["created_at"]
+  expire_after = 3600
+}
+`,
+				ExpectError: regexp.MustCompile("Index Already Exists"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["database"] + "/" + rs.Primary.Attributes["collection"] + "/" + rs.Primary.Attributes["id"], nil
+	}
+}
+
+func testAccIndexResourceConfig(databaseName string, collectionName string, unique bool) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_collection" "test" {
+  database = arangodb_database.test.name
+  name     = %[2]q
+}
+
+resource "arangodb_index" "persistent" {
+  database      = arangodb_database.test.name
+  collection    = arangodb_collection.test.name
+  name          = "by_email"
+  type          = "persistent"
+  fields        = ["email"]
+  unique        = %[3]t
+  stored_values = ["name"]
+  in_background = true
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+resource "arangodb_index" "ttl" {
+  database     = arangodb_database.test.name
+  collection   = arangodb_collection.test.name
+  name         = "expiry"
+  type         = "ttl"
+  fields       = ["created_at"]
+  expire_after = 3600
+}
+
+resource "arangodb_index" "geo" {
+  database   = arangodb_database.test.name
+  collection = arangodb_collection.test.name
+  name       = "location"
+  type       = "geo"
+  fields     = ["location"]
+  geo_json   = true
+}
+`, databaseName, collectionName, unique)
+}
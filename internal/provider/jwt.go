@@ -0,0 +1,64 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/arangodb/go-driver/v2/connection"
+	"time"
+)
+
+// superuserTokenLifetime is the validity of the tokens minted from the JWT secret.
+// A new token is minted when the server rejects an expired one.
+const superuserTokenLifetime = time.Hour
+
+// mintSuperuserToken creates a superuser JWT signed with the JWT secret of the server,
+// as described in https://docs.arangodb.com/stable/develop/http-api/authentication/#jwt-superuser-tokens
+func mintSuperuserToken(secret []byte, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "HS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"iss":       "arangodb",
+		"server_id": "terraform-provider-arangodb",
+		"iat":       now.Unix(),
+		"exp":       now.Add(superuserTokenLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// newSuperuserAuthWrapper authenticates requests with superuser tokens minted from the JWT secret,
+// a new token is minted whenever the server answers with 401 Unauthorized.
+func newSuperuserAuthWrapper(secret []byte) connection.Wrapper {
+	return connection.WrapAuthentication(func(_ context.Context, _ connection.Connection) (connection.Authentication, error) {
+		return newSuperuserAuthentication(secret)
+	})
+}
+
+func newSuperuserAuthentication(secret []byte) (connection.Authentication, error) {
+	token, err := mintSuperuserToken(secret, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return connection.NewHeaderAuth("Authorization", "bearer %s", token), nil
+}
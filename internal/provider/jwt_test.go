@@ -0,0 +1,124 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMintSuperuserToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Unix(1700000000, 0)
+
+	token, err := mintSuperuserToken(secret, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected three token parts, got: %q", token)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+		t.Error("expected the token to be signed with the secret")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var claims struct {
+		Exp int64  `json:"exp"`
+		Iat int64  `json:"iat"`
+		Iss string `json:"iss"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Iss != "arangodb" || claims.Iat != now.Unix() || claims.Exp != now.Add(superuserTokenLifetime).Unix() {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+}
+
+func TestConfigureJwtSecret(t *testing.T) {
+	var authorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"server":"arango","version":"3.12.4","license":"community"}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	arangodbProvider := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	arangodbProvider.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	values["endpoint"] = tftypes.NewValue(tftypes.String, server.URL)
+	values["jwt_secret"] = tftypes.NewValue(tftypes.String, "secret")
+	values["skip_server_check"] = tftypes.NewValue(tftypes.Bool, true)
+	values["tls"] = tftypes.NewValue(tftypes.Bool, false)
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}
+
+	var resp provider.ConfigureResponse
+	arangodbProvider.Configure(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if _, err := resp.ResourceData.(*ProviderData).Client.Version(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	token, found := strings.CutPrefix(authorization, "bearer ")
+	parts := strings.Split(token, ".")
+
+	if !found || len(parts) != 3 {
+		t.Fatalf("expected a bearer token, got: %q", authorization)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[2] {
+		t.Error("expected the request to carry a token minted from the JWT secret")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// secretPatterns match JWTs and passwords which may be echoed in error messages of the server.
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	regexp.MustCompile(`(?i)"(passw(or)?d|jwt|secret)"\s*:\s*"[^"]*"`),
+}
+
+// loggingTransport logs every request sent to the server at debug level.
+// Request bodies and the Authorization header are never logged.
+type loggingTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.MaskAllFieldValuesRegexes(req.Context(), secretPatterns...)
+	ctx = tflog.MaskMessageRegexes(ctx, secretPatterns...)
+
+	fields := map[string]any{
+		"method":   req.Method,
+		"endpoint": req.URL.Scheme + "://" + req.URL.Host,
+		"path":     req.URL.EscapedPath(),
+	}
+
+	if database := requestDatabase(req.URL); database != "" {
+		fields["database"] = database
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+
+		tflog.Debug(ctx, "ArangoDB request failed", fields)
+
+		return resp, err
+	}
+
+	fields["status_code"] = resp.StatusCode
+
+	if errorNumber, errorMessage := arangoError(resp); errorNumber != 0 {
+		fields["error_number"] = errorNumber
+		fields["error_message"] = errorMessage
+	}
+
+	tflog.Debug(ctx, "ArangoDB request", fields)
+
+	return resp, nil
+}
+
+// requestDatabase returns the database of requests sent to /_db/<database>/.
+func requestDatabase(u *url.URL) string {
+	parts := strings.SplitN(strings.TrimPrefix(u.EscapedPath(), "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != "_db" {
+		return ""
+	}
+
+	database, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return parts[1]
+	}
+
+	return database
+}
+
+// arangoError returns the error number and message of an error response, the body is kept readable for the caller.
+func arangoError(resp *http.Response) (int, string) {
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return 0, ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return 0, ""
+	}
+
+	var response struct {
+		ErrorMessage string `json:"errorMessage"`
+		ErrorNum     int    `json:"errorNum"`
+	}
+
+	_ = json.Unmarshal(body, &response)
+
+	return response.ErrorNum, response.ErrorMessage
+}
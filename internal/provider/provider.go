@@ -4,21 +4,35 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
 	"github.com/arangodb/go-driver/v2/connection"
-	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure ArangodbProvider satisfies various provider interfaces.
@@ -33,12 +47,43 @@ type ArangodbProvider struct {
 	version string
 }
 
+// ProviderData is passed to the resources when the provider is configured.
+type ProviderData struct {
+	Client arangodb.Client
+
+	// RequestTimeout limits resource operations which have no timeout configured in their timeouts block.
+	RequestTimeout time.Duration
+
+	// Server describes the connected server, it is nil when skip_server_check is set.
+	Server *ServerInfo
+}
+
+// defaultRequestTimeout is used when the provider configuration does not set a request timeout.
+const defaultRequestTimeout = 2 * time.Minute
+
 // ArangodbProviderModel describes the provider data model.
 type ArangodbProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Password types.String `tfsdk:"password"`
-	Tls      types.Bool   `tfsdk:"tls"`
-	Username types.String `tfsdk:"username"`
+	CaCertificate      types.String `tfsdk:"ca_certificate"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	Connection         types.Object `tfsdk:"connection"`
+	DiscoverEndpoints  types.Bool   `tfsdk:"discover_endpoints"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Endpoints          types.List   `tfsdk:"endpoints"`
+	Headers            types.Map    `tfsdk:"headers"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	JwtExchange        types.Bool   `tfsdk:"jwt_exchange"`
+	JwtSecret          types.String `tfsdk:"jwt_secret"`
+	JwtSecretFile      types.String `tfsdk:"jwt_secret_file"`
+	JwtToken           types.String `tfsdk:"jwt_token"`
+	Password           types.String `tfsdk:"password"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	Retry              types.Object `tfsdk:"retry"`
+	ServerName         types.String `tfsdk:"server_name"`
+	SkipServerCheck    types.Bool   `tfsdk:"skip_server_check"`
+	Tls                types.Bool   `tfsdk:"tls"`
+	Username           types.String `tfsdk:"username"`
 }
 
 func (p *ArangodbProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,21 +94,167 @@ func (p *ArangodbProvider) Metadata(ctx context.Context, req provider.MetadataRe
 func (p *ArangodbProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate authority used to verify the server, or the path to a file containing it. " +
+					"The system certificate pool is used when not set",
+				Optional: true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS, or the path to a file containing it. Requires `client_key`",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate, or the path to a file containing it. Requires `client_certificate`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"connection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the HTTP connections to the server, " +
+					"by default up to 100 idle HTTP/1.1 connections are kept open",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"http2": schema.BoolAttribute{
+						MarkdownDescription: "Use HTTP/2 instead of HTTP/1.1, negotiated during the TLS handshake for https endpoints " +
+							"and with prior knowledge for http endpoints, defaults to false. HTTP/2 is provided by the Go HTTP client " +
+							"instead of the HTTP/2 connection of the driver, so requests still fail over between endpoints and retry transient errors. " +
+							"Bodies are always sent as JSON, VelocyPack is not supported by go-driver v2",
+						Optional: true,
+					},
+					"idle_connection_timeout": schema.StringAttribute{
+						MarkdownDescription: "Time an idle connection is kept open before it is closed, defaults to '90s'",
+						Optional:            true,
+					},
+					"keep_alive": schema.StringAttribute{
+						MarkdownDescription: "Interval of the TCP keep-alive probes of open connections, 0 uses the Go default of 15s, defaults to '90s'",
+						Optional:            true,
+					},
+					"max_idle_connections": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of idle connections kept open per endpoint, defaults to 100",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"discover_endpoints": schema.BoolAttribute{
+				MarkdownDescription: "Discover the coordinators of the cluster when the provider is configured and use them as endpoints, defaults to false",
+				Optional:            true,
+			},
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Endpoint url",
-				Required:            true,
+				MarkdownDescription: "Endpoint url, can also be set with the `ARANGO_ENDPOINT` environment variable",
+				Optional:            true,
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "Endpoint urls of the cluster coordinators, requests are distributed across them and fail over to the next one " +
+					"when an endpoint cannot be reached. Can also be set with the `ARANGO_ENDPOINTS` environment variable as a comma separated list",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Headers added to every request, such as the ones required by an authenticating gateway",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the server certificate and host name, defaults to false. Only use this for testing",
+				Optional:            true,
+			},
+			"jwt_exchange": schema.BoolAttribute{
+				MarkdownDescription: "Exchange the username and password for a JWT with the `/_open/auth` endpoint, " +
+					"a new token is requested when it expires. Defaults to false",
+				Optional: true,
+			},
+			"jwt_secret": schema.StringAttribute{
+				MarkdownDescription: "JWT secret of the server, used to mint short-lived superuser tokens. " +
+					"Can also be set with the `ARANGO_JWT_SECRET` environment variable",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("jwt_secret_file"), path.MatchRoot("jwt_token")),
+				},
+			},
+			"jwt_secret_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the JWT secret of the server, used to mint short-lived superuser tokens. " +
+					"Can also be set with the `ARANGO_JWT_SECRET_FILE` environment variable",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("jwt_secret"), path.MatchRoot("jwt_token")),
+				},
+			},
+			"jwt_token": schema.StringAttribute{
+				MarkdownDescription: "Pre-issued JWT sent as bearer token instead of the username and password. " +
+					"Can also be set with the `ARANGO_JWT_TOKEN` environment variable",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("jwt_secret"), path.MatchRoot("jwt_secret_file")),
+				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password",
+				MarkdownDescription: "Password, can also be set with the `ARANGO_PASSWORD` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "Url of the http, https or socks5 proxy requests are sent through, hosts listed in the `NO_PROXY` environment variable " +
+					"are reached directly. Defaults to the `HTTPS_PROXY` and `HTTP_PROXY` environment variables",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Default time limit of resource operations, such as '30s' or '5m', defaults to '2m'. " +
+					"Single operations can be given more time with the timeouts block of the resource",
+				Optional: true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Retry policy for transient errors returned while a cluster changes leaders, " +
+					"by default 503 responses and cluster timeout and leadership errors are retried three times",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"error_numbers": schema.ListAttribute{
+						MarkdownDescription: "ArangoDB error numbers which are retried, defaults to [1457, 1477, 1495, 1496]",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "Maximum time to wait between retries, defaults to '30s'",
+						Optional:            true,
+					},
+					"max_retries": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of retries of a request, 0 disables retries, defaults to 3",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "Time to wait before the first retry, doubled for every further retry, defaults to '1s'",
+						Optional:            true,
+					},
+					"status_codes": schema.ListAttribute{
+						MarkdownDescription: "HTTP status codes which are retried, defaults to [503]",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+					},
+				},
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "Host name used to verify the server certificate and sent with SNI, defaults to the host of the endpoint",
 				Optional:            true,
 			},
+			"skip_server_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip the connection and version check of the server when the provider is configured, defaults to false. " +
+					"Useful for plan-only runs where the server is unreachable. Can also be set with the `ARANGO_SKIP_SERVER_CHECK` environment variable",
+				Optional: true,
+			},
 			"tls": schema.BoolAttribute{
-				MarkdownDescription: "Enable TLS, defaults to true",
+				MarkdownDescription: "Enable TLS, defaults to true, can also be set with the `ARANGO_TLS` environment variable",
 				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username",
-				Required:            true,
+				MarkdownDescription: "Username, can also be set with the `ARANGO_USERNAME` environment variable",
+				Optional:            true,
 			},
 		},
 	}
@@ -78,21 +269,310 @@ func (p *ArangodbProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	endpoint := connection.NewRoundRobinEndpoints([]string{data.Endpoint.ValueString()})
-	conn := connection.NewHttpConnection(jsonHttpConnectionConfig(endpoint, data.Tls.IsNull() || data.Tls.ValueBool()))
-	err := conn.SetAuthentication(connection.NewBasicAuth(data.Username.ValueString(), data.Password.ValueString()))
+	// Values only known after apply cannot be used to configure the client.
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"ca_certificate", data.CaCertificate},
+		{"client_certificate", data.ClientCertificate},
+		{"client_key", data.ClientKey},
+		{"connection", data.Connection},
+		{"discover_endpoints", data.DiscoverEndpoints},
+		{"endpoint", data.Endpoint},
+		{"endpoints", data.Endpoints},
+		{"headers", data.Headers},
+		{"insecure_skip_verify", data.InsecureSkipVerify},
+		{"jwt_exchange", data.JwtExchange},
+		{"jwt_secret", data.JwtSecret},
+		{"jwt_secret_file", data.JwtSecretFile},
+		{"jwt_token", data.JwtToken},
+		{"password", data.Password},
+		{"proxy_url", data.ProxyUrl},
+		{"request_timeout", data.RequestTimeout},
+		{"retry", data.Retry},
+		{"server_name", data.ServerName},
+		{"skip_server_check", data.SkipServerCheck},
+		{"tls", data.Tls},
+		{"username", data.Username},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown ArangoDB Provider Attribute",
+				fmt.Sprintf("The provider cannot create the ArangoDB client as there is an unknown configuration value for %q. "+
+					"Either set the value statically in the configuration or use the corresponding ARANGO_ environment variable.", attribute.name),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configuration values take precedence over environment variables.
+	endpointUrl := stringValueOrEnv(data.Endpoint, "ARANGO_ENDPOINT")
+	endpointUrls := []string{}
+	username := stringValueOrEnv(data.Username, "ARANGO_USERNAME")
+	password := stringValueOrEnv(data.Password, "ARANGO_PASSWORD")
+	jwtToken := stringValueOrEnv(data.JwtToken, "ARANGO_JWT_TOKEN")
+	jwtSecret := []byte(stringValueOrEnv(data.JwtSecret, "ARANGO_JWT_SECRET"))
+
+	tlsEnabled, err := boolValueOrEnv(data.Tls, "ARANGO_TLS", true)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tls"),
+			"Invalid ArangoDB TLS Setting",
+			"The TLS setting could not be read: "+err.Error(),
+		)
+	}
+
+	skipServerCheck, err := boolValueOrEnv(data.SkipServerCheck, "ARANGO_SKIP_SERVER_CHECK", false)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_server_check"),
+			"Invalid ArangoDB Server Check Setting",
+			"The server check setting could not be read: "+err.Error(),
+		)
+	}
+
+	if !data.Endpoints.IsNull() {
+		resp.Diagnostics.Append(data.Endpoints.ElementsAs(ctx, &endpointUrls, false)...)
+	} else if value := os.Getenv("ARANGO_ENDPOINTS"); value != "" {
+		for _, endpointsUrl := range strings.Split(value, ",") {
+			endpointUrls = append(endpointUrls, strings.TrimSpace(endpointsUrl))
+		}
+	}
+
+	for i, endpointsUrl := range endpointUrls {
+		if !isValidEndpoint(endpointsUrl) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoints").AtListIndex(i),
+				"Invalid ArangoDB Endpoint",
+				fmt.Sprintf("The endpoints must be absolute http or https urls such as \"https://localhost:8529\", got: %q.", endpointsUrl),
+			)
+		}
+	}
+
+	if endpointUrl != "" {
+		if !isValidEndpoint(endpointUrl) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid ArangoDB Endpoint",
+				fmt.Sprintf("The endpoint must be an absolute http or https url such as \"https://localhost:8529\", got: %q.", endpointUrl),
+			)
+		}
+
+		if !slices.Contains(endpointUrls, endpointUrl) {
+			endpointUrls = append([]string{endpointUrl}, endpointUrls...)
+		}
+	}
+
+	if len(endpointUrls) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Missing ArangoDB Endpoint",
+			"The provider cannot create the ArangoDB client as there is a missing or empty value for the endpoint. "+
+				"Set the endpoint or endpoints value in the configuration or use the ARANGO_ENDPOINT or ARANGO_ENDPOINTS environment variable.",
+		)
+	}
+
+	if jwtSecretFile := stringValueOrEnv(data.JwtSecretFile, "ARANGO_JWT_SECRET_FILE"); len(jwtSecret) == 0 && jwtSecretFile != "" {
+		content, err := os.ReadFile(jwtSecretFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("jwt_secret_file"),
+				"Invalid JWT Secret File",
+				"The JWT secret file could not be read: "+err.Error(),
+			)
+		}
+
+		jwtSecret = bytes.TrimSpace(content)
+	}
+
+	if username == "" && jwtToken == "" && len(jwtSecret) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing ArangoDB Username",
+			"The provider cannot create the ArangoDB client as there is a missing or empty value for the username. "+
+				"Set the username value in the configuration or use the ARANGO_USERNAME environment variable.",
+		)
+	}
+
+	requestTimeout := defaultRequestTimeout
+
+	if !data.RequestTimeout.IsNull() {
+		var err error
+
+		requestTimeout, err = time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("The request timeout must be a positive duration such as \"30s\" or \"5m\", got: %q.", data.RequestTimeout.ValueString()),
+			)
+		}
+	}
+
+	retry, diags := toRetryPolicy(ctx, data.Retry)
+	resp.Diagnostics.Append(diags...)
+
+	settings, diags := toConnectionSettings(ctx, data.Connection)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.ProxyUrl.IsNull() {
+		settings.proxy, err = newProxy(data.ProxyUrl.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy Url",
+				"The proxy url could not be used: "+err.Error(),
+			)
+		}
+	}
+
+	if !data.Headers.IsNull() {
+		headers := map[string]string{}
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+
+		settings.headers = http.Header{}
+
+		for key, value := range headers {
+			settings.headers.Set(key, value)
+		}
+	}
+
+	var tlsConfig *tls.Config
+
+	if tlsEnabled {
+		var diags diag.Diagnostics
+
+		tlsConfig, diags = newTlsConfig(data)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attempt is logged, including the ones repeated on other endpoints or after transient errors.
+	failover, err := newFailoverTransport(&loggingTransport{
+		transport: &headerTransport{
+			transport: newHttpTransport(tlsConfig, settings),
+			headers:   settings.headers,
+		},
+	}, endpointUrls)
+	if err != nil {
+		resp.Diagnostics.AddError("Endpoint configuration failed", fmt.Sprintf("Endpoint configuration failed: %v", err))
+
+		return
+	}
+
+	transport := &retryTransport{
+		transport: failover,
+		policy:    retry,
+	}
+
+	endpoint := connection.NewRoundRobinEndpoints(endpointUrls)
+	conn := connection.NewHttpConnection(httpConnectionConfig(endpoint, transport))
+	switch {
+	case jwtToken != "":
+		err = conn.SetAuthentication(connection.NewHeaderAuth("Authorization", "bearer %s", jwtToken))
+	case len(jwtSecret) > 0:
+		var authentication connection.Authentication
+
+		// The wrapper rejects SetAuthentication, the first token is set on the wrapped connection.
+		authentication, err = newSuperuserAuthentication(jwtSecret)
+
+		if err == nil {
+			err = conn.SetAuthentication(authentication)
+		}
+
+		conn = newSuperuserAuthWrapper(jwtSecret)(conn)
+	case data.JwtExchange.ValueBool():
+		// The wrapper requests a token when the server answers with 401 Unauthorized.
+		conn = connection.NewJWTAuthWrapper(username, password)(conn)
+	default:
+		err = conn.SetAuthentication(connection.NewBasicAuth(username, password))
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Authentication configuration failed", fmt.Sprintf("Authentication configuration failed: %v", err))
 	}
 
+	if data.DiscoverEndpoints.ValueBool() {
+		discovered, err := discoverEndpoints(ctx, conn)
+
+		if err == nil {
+			err = failover.SetEndpoints(discovered)
+		}
+
+		if err == nil {
+			err = conn.SetEndpoint(connection.NewRoundRobinEndpoints(discovered))
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("discover_endpoints"),
+				"Endpoint Discovery Failed",
+				"The coordinators of the cluster could not be discovered, the configured endpoints are used instead. "+
+					"Discovery is only supported by cluster deployments.\n\nError: "+err.Error(),
+			)
+		}
+	}
+
 	// Create a client
 	client := arangodb.NewClient(conn)
 
-	resp.ResourceData = &client
+	var server *ServerInfo
+
+	if !skipServerCheck {
+		checkCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		server, err = fetchServerInfo(checkCtx, client)
+		if err != nil {
+			if shared.IsUnauthorized(err) {
+				resp.Diagnostics.AddError(
+					"ArangoDB Authentication Failed",
+					"The server rejected the configured credentials. "+
+						"Check the username and password or the JWT settings of the provider.\n\n"+
+						"HTTP Error: "+err.Error(),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Unable to Connect to ArangoDB",
+					"The provider could not reach the server at the configured endpoints. "+
+						"Check the endpoints and TLS settings, or set skip_server_check for plan-only runs.\n\n"+
+						"Error: "+err.Error(),
+				)
+			}
+
+			return
+		}
+
+		tflog.Info(ctx, "Connected to ArangoDB", map[string]any{
+			"version": string(server.Version),
+			"edition": server.Edition(),
+			"role":    string(server.Role),
+		})
+	}
+
+	resp.ResourceData = &ProviderData{
+		Client:         client,
+		RequestTimeout: requestTimeout,
+		Server:         server,
+	}
 }
 
 func (p *ArangodbProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAnalyzerResource,
+		NewArangoSearchViewResource,
+		NewCollectionResource,
+		NewGraphResource,
+		NewIndexResource,
+		NewSearchAliasViewResource,
 		NewDatabaseResource,
 		NewUserPermissionResource,
 		NewUserResource,
@@ -115,25 +595,108 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func jsonHttpConnectionConfig(endpoint connection.Endpoint, tlsEnabled bool) connection.HttpConfiguration {
-	var tlsConfig *tls.Config = nil
-	if tlsEnabled {
-		tlsConfig = &tls.Config{InsecureSkipVerify: true}
-	}
-
-	return connection.HttpConfiguration{
-		Endpoint:    endpoint,
-		ContentType: connection.ApplicationJSON,
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 90 * time.Second,
-			}).DialContext,
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		},
+// stringValueOrEnv returns the configured value, or the environment variable when the attribute is not set.
+func stringValueOrEnv(value types.String, key string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(key)
+}
+
+// newTlsConfig builds the TLS configuration from the certificate attributes of the provider.
+func newTlsConfig(data ArangodbProviderModel) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tlsConfig := &tls.Config{
+		ServerName:         data.ServerName.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	if !data.CaCertificate.IsNull() {
+		caCertificate, err := readPem(data.CaCertificate.ValueString())
+
+		if err == nil {
+			tlsConfig.RootCAs = x509.NewCertPool()
+
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCertificate) {
+				err = fmt.Errorf("no PEM encoded certificate found")
+			}
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_certificate"),
+				"Invalid CA Certificate",
+				"The CA certificate could not be loaded: "+err.Error(),
+			)
+		}
 	}
+
+	if data.ClientCertificate.IsNull() != data.ClientKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("client_certificate"),
+			"Incomplete Client Certificate",
+			"Both client_certificate and client_key must be set to use a client certificate.",
+		)
+	} else if !data.ClientCertificate.IsNull() {
+		clientCertificate, err := readPem(data.ClientCertificate.ValueString())
+
+		var clientKey []byte
+		if err == nil {
+			clientKey, err = readPem(data.ClientKey.ValueString())
+		}
+
+		var certificate tls.Certificate
+		if err == nil {
+			certificate, err = tls.X509KeyPair(clientCertificate, clientKey)
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_certificate"),
+				"Invalid Client Certificate",
+				"The client certificate could not be loaded: "+err.Error(),
+			)
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+	}
+
+	return tlsConfig, diags
+}
+
+// readPem returns PEM content as is, any other value is read as the path of a file containing it.
+func readPem(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
+
+// boolValueOrEnv returns the configured value, or the environment variable when the attribute is not set.
+func boolValueOrEnv(value types.Bool, key string, defaultValue bool) (bool, error) {
+	if !value.IsNull() {
+		return value.ValueBool(), nil
+	}
+
+	env := os.Getenv(key)
+	if env == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		return defaultValue, fmt.Errorf("the %s environment variable must be a boolean, got: %q", key, env)
+	}
+
+	return parsed, nil
+}
+
+// isValidEndpoint reports whether the endpoint is an absolute http or https url.
+func isValidEndpoint(endpoint string) bool {
+	parsed, err := url.Parse(endpoint)
+
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
 }
@@ -6,21 +6,28 @@ package provider
 import (
 	"testing"
 
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/connection"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
 const (
+	// testAccEndpoint, testAccUsername and testAccPassword locate the server the acceptance tests run against.
+	testAccEndpoint = "http://localhost:8529"
+	testAccUsername = "root"
+	testAccPassword = "password"
+
 	// providerConfig is a shared configuration to combine with the actual
 	// test configuration so the HashiCups client is properly configured.
 	// It is also possible to use the HASHICUPS_ environment variables instead,
 	// such as updating the Makefile and running the testing through that tool.
 	providerConfig = `
 provider "arangodb" {
-  endpoint = "http://localhost:8529"
+  endpoint = "` + testAccEndpoint + `"
   tls      = false
-  username = "root"
-  password = "password"
+  username = "` + testAccUsername + `"
+  password = "` + testAccPassword + `"
 }
 `
 )
@@ -38,3 +45,15 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccClient connects to the server of providerConfig.
+func testAccClient() (arangodb.Client, error) {
+	endpoint := connection.NewRoundRobinEndpoints([]string{testAccEndpoint})
+	conn := connection.NewHttpConnection(connection.DefaultHTTPConfigurationWrapper(endpoint, false))
+
+	if err := conn.SetAuthentication(connection.NewBasicAuth(testAccUsername, testAccPassword)); err != nil {
+		return nil, err
+	}
+
+	return arangodb.NewClient(conn), nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// retryPolicy describes which responses are retried and how long to wait in between.
+type retryPolicy struct {
+	maxRetries   int
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	statusCodes  []int
+	errorNumbers []int
+}
+
+// defaultRetryPolicy retries the errors returned by a cluster while a leader change is in progress.
+var defaultRetryPolicy = retryPolicy{
+	maxRetries:  3,
+	minBackoff:  time.Second,
+	maxBackoff:  30 * time.Second,
+	statusCodes: []int{http.StatusServiceUnavailable},
+	// ERROR_CLUSTER_TIMEOUT, ERROR_CLUSTER_BACKEND_UNAVAILABLE, ERROR_CLUSTER_LEADERSHIP_CHALLENGE_ONGOING, ERROR_CLUSTER_NOT_LEADER
+	errorNumbers: []int{1457, 1477, 1495, 1496},
+}
+
+// ProviderRetryModel describes the retry settings of the provider.
+type ProviderRetryModel struct {
+	ErrorNumbers types.List   `tfsdk:"error_numbers"`
+	MaxBackoff   types.String `tfsdk:"max_backoff"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	MinBackoff   types.String `tfsdk:"min_backoff"`
+	StatusCodes  types.List   `tfsdk:"status_codes"`
+}
+
+// toRetryPolicy merges the configured retry settings with the default retry policy.
+func toRetryPolicy(ctx context.Context, value types.Object) (retryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data ProviderRetryModel
+
+	policy := defaultRetryPolicy
+
+	if value.IsNull() || value.IsUnknown() {
+		return policy, diags
+	}
+
+	diags.Append(value.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+
+	if !data.MaxRetries.IsNull() {
+		policy.maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	for _, backoff := range []struct {
+		name  string
+		value types.String
+		into  *time.Duration
+	}{
+		{"min_backoff", data.MinBackoff, &policy.minBackoff},
+		{"max_backoff", data.MaxBackoff, &policy.maxBackoff},
+	} {
+		if backoff.value.IsNull() {
+			continue
+		}
+
+		duration, err := time.ParseDuration(backoff.value.ValueString())
+		if err != nil || duration < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(backoff.name),
+				"Invalid Retry Backoff",
+				fmt.Sprintf("The backoff must be a positive duration such as \"500ms\" or \"10s\", got: %q.", backoff.value.ValueString()),
+			)
+		}
+
+		*backoff.into = duration
+	}
+
+	if policy.minBackoff > policy.maxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("max_backoff"),
+			"Invalid Retry Backoff",
+			"The maximum backoff must not be shorter than the minimum backoff.",
+		)
+	}
+
+	if !data.StatusCodes.IsNull() {
+		policy.statusCodes = nil
+		diags.Append(data.StatusCodes.ElementsAs(ctx, &policy.statusCodes, false)...)
+	}
+
+	if !data.ErrorNumbers.IsNull() {
+		policy.errorNumbers = nil
+		diags.Append(data.ErrorNumbers.ElementsAs(ctx, &policy.errorNumbers, false)...)
+	}
+
+	return policy, diags
+}
+
+// backoff returns the time to wait before the given retry, doubling from the minimum up to the maximum backoff.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	backoff := p.minBackoff
+
+	for i := 0; i < retry && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, p.maxBackoff)
+}
+
+// retryTransport sends a request again when the server answers with a transient error of the retry policy.
+type retryTransport struct {
+	transport http.RoundTripper
+	policy    retryPolicy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for retry := 0; ; retry++ {
+		attempt := req
+
+		if retry > 0 {
+			attempt = req.Clone(ctx)
+
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				attempt.Body = body
+			}
+		}
+
+		resp, err := t.transport.RoundTrip(attempt)
+		if err != nil || retry >= t.policy.maxRetries || !replayable {
+			return resp, err
+		}
+
+		errorNumber, retryable := t.retryable(resp)
+		if !retryable {
+			return resp, nil
+		}
+
+		backoff := t.policy.backoff(retry)
+
+		tflog.Warn(ctx, "Retrying ArangoDB request after transient error", map[string]any{
+			"method":       req.Method,
+			"url":          req.URL.Redacted(),
+			"status_code":  resp.StatusCode,
+			"error_number": errorNumber,
+			"retry":        retry + 1,
+			"max_retries":  t.policy.maxRetries,
+			"backoff":      backoff.String(),
+		})
+
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(backoff)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether the response is a transient error, the body is kept readable for the caller.
+func (t *retryTransport) retryable(resp *http.Response) (int, bool) {
+	if resp.StatusCode < http.StatusBadRequest {
+		return 0, false
+	}
+
+	errorNumber, _ := arangoError(resp)
+
+	return errorNumber, slices.Contains(t.policy.statusCodes, resp.StatusCode) || slices.Contains(t.policy.errorNumbers, errorNumber)
+}
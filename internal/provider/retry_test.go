@@ -0,0 +1,77 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		body, _ := io.ReadAll(r.Body)
+
+		switch requests {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":true,"errorNum":1496}`))
+		case 3:
+			_, _ = w.Write(body)
+		default:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":true,"errorNum":1210}`))
+		}
+	}))
+	defer server.Close()
+
+	policy := defaultRetryPolicy
+	policy.minBackoff = time.Millisecond
+	policy.maxBackoff = time.Millisecond
+
+	client := &http.Client{Transport: &retryTransport{transport: http.DefaultTransport, policy: policy}}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if requests != 3 || string(body) != `{"name":"test"}` {
+		t.Errorf("expected the request to succeed on the third attempt, got %d attempts and body %q", requests, body)
+	}
+
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if requests != 4 || resp.StatusCode != http.StatusConflict || !strings.Contains(string(body), "1210") {
+		t.Errorf("expected a non transient error to be returned as is, got %d attempts and body %q", requests, body)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{minBackoff: time.Second, maxBackoff: 5 * time.Second}
+
+	for retry, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if backoff := policy.backoff(retry); backoff != expected {
+			t.Errorf("expected backoff %s for retry %d, got %s", expected, retry, backoff)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"strconv"
+	"strings"
+)
+
+// communityIncludesEnterprise is the first version whose Community Edition includes the Enterprise Edition features.
+const communityIncludesEnterprise = arangodb.Version("3.12.5")
+
+// serverRequirement describes the server version and edition a feature needs.
+type serverRequirement struct {
+	feature    string
+	minVersion arangodb.Version
+	enterprise bool
+}
+
+// ServerInfo describes the ArangoDB deployment the provider is connected to.
+type ServerInfo struct {
+	Enterprise bool
+	Role       arangodb.ServerRole
+	Version    arangodb.Version
+}
+
+// Edition returns the name of the edition of the server.
+func (s ServerInfo) Edition() string {
+	if s.Enterprise {
+		return "Enterprise"
+	}
+
+	return "Community"
+}
+
+// fetchServerInfo requests the version and role of the server, it fails when the server cannot be reached
+// or rejects the credentials.
+func fetchServerInfo(ctx context.Context, client arangodb.Client) (*ServerInfo, error) {
+	version, err := client.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := client.ServerRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServerInfo{
+		Enterprise: version.IsEnterprise(),
+		Role:       role,
+		Version:    version.Version,
+	}, nil
+}
+
+// AtLeast reports whether the server runs the given version or a later one.
+func (s ServerInfo) AtLeast(version arangodb.Version) bool {
+	current, minimum := versionParts(s.Version), versionParts(version)
+
+	for i := range current {
+		if current[i] != minimum[i] {
+			return current[i] > minimum[i]
+		}
+	}
+
+	return true
+}
+
+// Require returns an error for the attribute when the server does not support the feature.
+// Nothing is checked when the server is unknown because skip_server_check is set.
+func (s *ServerInfo) Require(attributePath path.Path, requirement serverRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s == nil {
+		return diags
+	}
+
+	edition := ""
+	supported := requirement.minVersion == "" || s.AtLeast(requirement.minVersion)
+
+	if requirement.enterprise {
+		edition = " Enterprise"
+		supported = supported && (s.Enterprise || s.AtLeast(communityIncludesEnterprise))
+	}
+
+	if !supported {
+		version := ""
+		if requirement.minVersion != "" {
+			version = " >= " + string(requirement.minVersion)
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			"Unsupported ArangoDB Feature",
+			fmt.Sprintf("%s requires ArangoDB%s%s, the server runs ArangoDB %s %s.",
+				requirement.feature, version, edition, s.Version, s.Edition()),
+		)
+	}
+
+	return diags
+}
+
+// versionParts returns the major, minor and patch numbers of a version such as "3.12.4-1".
+func versionParts(version arangodb.Version) [3]int {
+	var parts [3]int
+
+	for i, part := range strings.SplitN(string(version), ".", 3) {
+		end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if end >= 0 {
+			part = part[:end]
+		}
+
+		parts[i], _ = strconv.Atoi(part)
+	}
+
+	return parts
+}
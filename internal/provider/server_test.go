@@ -0,0 +1,64 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestServerInfoAtLeast(t *testing.T) {
+	for _, test := range []struct {
+		version  arangodb.Version
+		minimum  arangodb.Version
+		expected bool
+	}{
+		{"3.12.4", "3.12.0", true},
+		{"3.12.4-1", "3.12.4", true},
+		{"3.11.10", "3.12.0", false},
+		{"3.10.0", "3.9.12", true},
+		{"3.10.4", "3.10.5", false},
+		{"4.0.0-devel", "3.12.5", true},
+	} {
+		if actual := (ServerInfo{Version: test.version}).AtLeast(test.minimum); actual != test.expected {
+			t.Errorf("expected %s at least %s to be %t", test.version, test.minimum, test.expected)
+		}
+	}
+}
+
+func TestServerInfoRequire(t *testing.T) {
+	requirement := serverRequirement{feature: "The minhash Analyzer", minVersion: "3.10.0", enterprise: true}
+
+	var unknown *ServerInfo
+	if diags := unknown.Require(path.Root("type"), requirement); diags.HasError() {
+		t.Errorf("expected no error for an unknown server, got: %v", diags)
+	}
+
+	community := &ServerInfo{Version: "3.11.5", Role: arangodb.ServerRoleSingle}
+	diags := community.Require(path.Root("type"), requirement)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error for the Community Edition")
+	}
+
+	if detail := diags[0].Detail(); !strings.Contains(detail, "requires ArangoDB >= 3.10.0 Enterprise") || !strings.Contains(detail, "3.11.5 Community") {
+		t.Errorf("unexpected error detail: %s", detail)
+	}
+
+	enterprise := &ServerInfo{Version: "3.11.5", Enterprise: true}
+	if diags := enterprise.Require(path.Root("type"), requirement); diags.HasError() {
+		t.Errorf("expected no error for the Enterprise Edition, got: %v", diags)
+	}
+
+	if diags := (&ServerInfo{Version: "3.12.5"}).Require(path.Root("type"), requirement); diags.HasError() {
+		t.Errorf("expected no error for a Community Edition including the Enterprise features, got: %v", diags)
+	}
+
+	if diags := (&ServerInfo{Version: "3.9.0", Enterprise: true}).Require(path.Root("type"), requirement); !diags.HasError() {
+		t.Error("expected an error for an older server")
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"golang.org/x/net/http/httpproxy"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// connectionSettings describes the HTTP connections to the server.
+type connectionSettings struct {
+	http2                 bool
+	idleConnectionTimeout time.Duration
+	keepAlive             time.Duration
+	maxIdleConnections    int
+
+	// headers are added to every request, proxy chooses the proxy of a request.
+	headers http.Header
+	proxy   func(*http.Request) (*url.URL, error)
+}
+
+// defaultConnectionSettings keeps up to 100 idle HTTP/1.1 connections.
+var defaultConnectionSettings = connectionSettings{
+	http2:                 false,
+	idleConnectionTimeout: 90 * time.Second,
+	keepAlive:             90 * time.Second,
+	maxIdleConnections:    100,
+	proxy:                 http.ProxyFromEnvironment,
+}
+
+// ProviderConnectionModel describes the connection settings of the provider.
+type ProviderConnectionModel struct {
+	Http2                 types.Bool   `tfsdk:"http2"`
+	IdleConnectionTimeout types.String `tfsdk:"idle_connection_timeout"`
+	KeepAlive             types.String `tfsdk:"keep_alive"`
+	MaxIdleConnections    types.Int64  `tfsdk:"max_idle_connections"`
+}
+
+// toConnectionSettings merges the configured connection settings with the default connection settings.
+func toConnectionSettings(ctx context.Context, value types.Object) (connectionSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data ProviderConnectionModel
+
+	settings := defaultConnectionSettings
+
+	if value.IsNull() || value.IsUnknown() {
+		return settings, diags
+	}
+
+	diags.Append(value.As(ctx, &data, basetypes.ObjectAsOptions{})...)
+
+	if !data.Http2.IsNull() {
+		settings.http2 = data.Http2.ValueBool()
+	}
+
+	if !data.MaxIdleConnections.IsNull() {
+		settings.maxIdleConnections = int(data.MaxIdleConnections.ValueInt64())
+	}
+
+	for _, duration := range []struct {
+		name  string
+		value types.String
+		into  *time.Duration
+	}{
+		{"idle_connection_timeout", data.IdleConnectionTimeout, &settings.idleConnectionTimeout},
+		{"keep_alive", data.KeepAlive, &settings.keepAlive},
+	} {
+		if duration.value.IsNull() {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(duration.value.ValueString())
+		if err != nil || parsed < 0 {
+			diags.AddAttributeError(
+				path.Root("connection").AtName(duration.name),
+				"Invalid Connection Setting",
+				fmt.Sprintf("The %s must be a positive duration such as \"30s\" or \"2m\", got: %q.", duration.name, duration.value.ValueString()),
+			)
+		}
+
+		*duration.into = parsed
+	}
+
+	return settings, diags
+}
+
+// newHttpTransport creates the transport of the connection, with HTTP/2 plain http endpoints are spoken to
+// with prior knowledge and https endpoints negotiate HTTP/2 during the TLS handshake.
+// The HTTP/2 connection of the driver is not used as it requires an *http2.Transport,
+// which cannot be wrapped by the failover and retry transports.
+func newHttpTransport(tlsConfig *tls.Config, settings connectionSettings) *http.Transport {
+	transport := &http.Transport{
+		Proxy:           settings.proxy,
+		TLSClientConfig: tlsConfig,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: settings.keepAlive,
+		}).DialContext,
+		MaxIdleConns:          settings.maxIdleConnections,
+		MaxIdleConnsPerHost:   settings.maxIdleConnections,
+		IdleConnTimeout:       settings.idleConnectionTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	if settings.http2 {
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP2(true)
+		transport.Protocols.SetUnencryptedHTTP2(true)
+	}
+
+	return transport
+}
+
+// newProxy sends requests through the proxy url, hosts listed in the NO_PROXY environment variable are reached directly.
+func newProxy(proxyUrl string) (func(*http.Request) (*url.URL, error), error) {
+	parsed, err := url.Parse(proxyUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "socks5") || parsed.Host == "" {
+		return nil, fmt.Errorf("the proxy url must be an absolute http, https or socks5 url, got: %q", parsed.Redacted())
+	}
+
+	config := httpproxy.FromEnvironment()
+	config.HTTPProxy = proxyUrl
+	config.HTTPSProxy = proxyUrl
+
+	proxy := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+// headerTransport adds the configured headers to every request, such as the ones required by an authenticating gateway.
+type headerTransport struct {
+	transport http.RoundTripper
+	headers   http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.headers) == 0 {
+		return t.transport.RoundTrip(req)
+	}
+
+	// A round tripper must not modify the request it was given.
+	req = req.Clone(req.Context())
+
+	for key, values := range t.headers {
+		req.Header[key] = values
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// httpConnectionConfig sends bodies as JSON, go-driver v2 cannot encode VelocyPack bodies.
+func httpConnectionConfig(endpoint connection.Endpoint, transport http.RoundTripper) connection.HttpConfiguration {
+	return connection.HttpConfiguration{
+		Endpoint:    endpoint,
+		ContentType: connection.ApplicationJSON,
+		Transport:   transport,
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHttpTransportHttp2(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.Protocols = new(http.Protocols)
+	server.Config.Protocols.SetHTTP1(true)
+	server.Config.Protocols.SetUnencryptedHTTP2(true)
+	server.Start()
+	defer server.Close()
+
+	for _, http2 := range []bool{false, true} {
+		settings := defaultConnectionSettings
+		settings.http2 = http2
+
+		client := &http.Client{Transport: newHttpTransport(nil, settings)}
+
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_ = resp.Body.Close()
+
+		if expected := map[bool]int{false: 1, true: 2}[http2]; resp.ProtoMajor != expected {
+			t.Errorf("expected HTTP/%d with http2 %t, got %s", expected, http2, resp.Proto)
+		}
+	}
+}
+
+func TestToConnectionSettings(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		"http2":                   types.BoolType,
+		"idle_connection_timeout": types.StringType,
+		"keep_alive":              types.StringType,
+		"max_idle_connections":    types.Int64Type,
+	}
+
+	value := types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"http2":                   types.BoolValue(true),
+		"idle_connection_timeout": types.StringValue("2m"),
+		"keep_alive":              types.StringNull(),
+		"max_idle_connections":    types.Int64Value(10),
+	})
+
+	settings, diags := toConnectionSettings(context.Background(), value)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !settings.http2 || settings.maxIdleConnections != 10 || settings.idleConnectionTimeout.String() != "2m0s" || settings.keepAlive != defaultConnectionSettings.keepAlive {
+		t.Errorf("unexpected connection settings: %+v", settings)
+	}
+
+	value = types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"http2":                   types.BoolNull(),
+		"idle_connection_timeout": types.StringNull(),
+		"keep_alive":              types.StringValue("-1s"),
+		"max_idle_connections":    types.Int64Null(),
+	})
+
+	if _, diags = toConnectionSettings(context.Background(), value); !diags.HasError() {
+		t.Errorf("expected a negative keep alive to be rejected")
+	}
+}
+
+func TestHeaderTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("X-Gateway-Token")))
+	}))
+	defer server.Close()
+
+	headers := http.Header{}
+	headers.Set("x-gateway-token", "token")
+
+	client := &http.Client{Transport: &headerTransport{transport: http.DefaultTransport, headers: headers}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if string(body) != "token" {
+		t.Errorf("expected the configured header to be sent, got %q", body)
+	}
+}
+
+func TestNewProxy(t *testing.T) {
+	t.Setenv("NO_PROXY", "direct.example.com")
+
+	proxy, err := newProxy("http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for endpoint, expected := range map[string]string{
+		"https://arangodb.example.com:8529": "http://proxy.example.com:3128",
+		"https://direct.example.com:8529":   "",
+	} {
+		req, _ := http.NewRequest(http.MethodGet, endpoint, nil)
+
+		proxyUrl, err := proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := ""
+		if proxyUrl != nil {
+			actual = proxyUrl.String()
+		}
+
+		if actual != expected {
+			t.Errorf("expected proxy %q for %s, got %v", expected, endpoint, proxyUrl)
+		}
+	}
+
+	if _, err := newProxy("proxy.example.com"); err == nil {
+		t.Errorf("expected a proxy url without scheme to be rejected")
+	}
+}
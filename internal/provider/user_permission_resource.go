@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"github.com/arangodb/go-driver/v2/arangodb"
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,26 +30,37 @@ func NewUserPermissionResource() resource.Resource {
 
 // UserPermissionResource defines the resource implementation.
 type UserPermissionResource struct {
-	client arangodb.Client
+	client         arangodb.Client
+	defaultTimeout time.Duration
 }
 
 // UserPermissionResourceModel describes the resource data model.
 type UserPermissionResourceModel struct {
-	Database   types.String `tfsdk:"database"`
-	Permission types.String `tfsdk:"permission"`
-	User       types.String `tfsdk:"user"`
+	Collection types.String   `tfsdk:"collection"`
+	Database   types.String   `tfsdk:"database"`
+	Id         types.String   `tfsdk:"id"`
+	Permission types.String   `tfsdk:"permission"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+	User       types.String   `tfsdk:"user"`
 }
 
 func (r *UserPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user_permission"
 }
 
-func (r *UserPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserPermissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "A user permission to access a database",
+		MarkdownDescription: "A user permission to access a database or a collection",
 
 		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				MarkdownDescription: "Collection name, the permission applies to this collection only when set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Optional: true,
+			},
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				PlanModifiers: []planmodifier.String{
@@ -53,8 +68,15 @@ func (r *UserPermissionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 				Required: true,
 			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the permission with format user/database or user/database/collection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"permission": schema.StringAttribute{
-				MarkdownDescription: "Permission to access the database, can be 'ro' for read only, 'rw' for read-write or 'none'",
+				MarkdownDescription: "Permission to access the database or collection, can be 'ro' for read only, 'rw' for read-write or 'none'",
 				Required:            true,
 			},
 			"user": schema.StringAttribute{
@@ -65,6 +87,14 @@ func (r *UserPermissionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -74,18 +104,19 @@ func (r *UserPermissionResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*arangodb.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *arangodb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = *client
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
 }
 
 func (r *UserPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -98,6 +129,16 @@ func (r *UserPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	user, errGetUser := r.client.User(ctx, data.User.ValueString())
 	if errGetUser != nil {
 		resp.Diagnostics.AddError(
@@ -110,7 +151,7 @@ func (r *UserPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	err := user.SetDatabaseAccess(ctx, data.Database.ValueString(), arangodb.Grant(data.Permission.ValueString()))
+	err := setUserPermission(ctx, user, data)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Resource",
@@ -122,6 +163,8 @@ func (r *UserPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	data.Id = types.StringValue(userPermissionId(data))
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -136,6 +179,16 @@ func (r *UserPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	user, err := r.client.User(ctx, data.User.ValueString())
 
 	if err != nil {
@@ -152,7 +205,7 @@ func (r *UserPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	access, err := user.GetDatabaseAccess(ctx, data.Database.ValueString())
+	access, err := getUserPermission(ctx, user, data)
 	if err != nil {
 		if shared.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -167,6 +220,7 @@ func (r *UserPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	data.Id = types.StringValue(userPermissionId(data))
 	data.Permission = types.StringValue(string(access))
 
 	// Save updated data into Terraform state
@@ -183,6 +237,16 @@ func (r *UserPermissionResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	user, errUser := r.client.User(ctx, data.User.ValueString())
 
 	if errUser != nil {
@@ -196,7 +260,7 @@ func (r *UserPermissionResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	errDatabase := user.SetDatabaseAccess(ctx, data.Database.ValueString(), arangodb.Grant(data.Permission.ValueString()))
+	errDatabase := setUserPermission(ctx, user, data)
 	if errDatabase != nil {
 		if shared.IsNotFound(errDatabase) {
 			resp.Diagnostics.AddError(
@@ -230,6 +294,16 @@ func (r *UserPermissionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	user, errUser := r.client.User(ctx, data.User.ValueString())
 
 	if errUser != nil {
@@ -243,7 +317,7 @@ func (r *UserPermissionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err := user.RemoveDatabaseAccess(ctx, data.Database.ValueString())
+	err := removeUserPermission(ctx, user, data)
 	if err != nil && !shared.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Resource",
@@ -257,5 +331,59 @@ func (r *UserPermissionResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *UserPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user/database or user/database/collection. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[1])...)
+
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), parts[2])...)
+	}
+}
+
+func userPermissionId(data UserPermissionResourceModel) string {
+	parts := []string{data.User.ValueString(), data.Database.ValueString()}
+
+	if !data.Collection.IsNull() {
+		parts = append(parts, data.Collection.ValueString())
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// setUserPermission grants the permission on the collection when one is set, on the database otherwise.
+func setUserPermission(ctx context.Context, user arangodb.User, data UserPermissionResourceModel) error {
+	grant := arangodb.Grant(data.Permission.ValueString())
+
+	if !data.Collection.IsNull() {
+		return user.SetCollectionAccess(ctx, data.Database.ValueString(), data.Collection.ValueString(), grant)
+	}
+
+	return user.SetDatabaseAccess(ctx, data.Database.ValueString(), grant)
+}
+
+func getUserPermission(ctx context.Context, user arangodb.User, data UserPermissionResourceModel) (arangodb.Grant, error) {
+	if !data.Collection.IsNull() {
+		return user.GetCollectionAccess(ctx, data.Database.ValueString(), data.Collection.ValueString())
+	}
+
+	return user.GetDatabaseAccess(ctx, data.Database.ValueString())
+}
+
+func removeUserPermission(ctx context.Context, user arangodb.User, data UserPermissionResourceModel) error {
+	if !data.Collection.IsNull() {
+		return user.RemoveCollectionAccess(ctx, data.Database.ValueString(), data.Collection.ValueString())
+	}
+
+	return user.RemoveDatabaseAccess(ctx, data.Database.ValueString())
 }
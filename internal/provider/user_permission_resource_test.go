@@ -20,16 +20,18 @@ func TestAccUserPermissionResource(t *testing.T) {
 				Config: testUserPermissionResourceConfig("database_name", "rw", "user"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("arangodb_user_permission.test", "database", "database_name"),
+					resource.TestCheckResourceAttr("arangodb_user_permission.test", "id", "user/database_name"),
 					resource.TestCheckResourceAttr("arangodb_user_permission.test", "permission", "rw"),
 					resource.TestCheckResourceAttr("arangodb_user_permission.test", "user", "user"),
 				),
 			},
-			//// ImportState testing
-			//{
-			//	ResourceName:      "arangodb_database.test",
-			//	ImportState:       true,
-			//	ImportStateVerify: true,
-			//},
+			// ImportState testing
+			{
+				ResourceName:      "arangodb_user_permission.test",
+				ImportState:       true,
+				ImportStateId:     "user/database_name",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testUserPermissionResourceConfig("database_name", "ro", "user"),
@@ -37,6 +39,22 @@ func TestAccUserPermissionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("arangodb_user_permission.test", "permission", "ro"),
 				),
 			},
+			// Collection permission testing
+			{
+				Config: testUserPermissionResourceConfig("database_name", "ro", "user") + testUserPermissionCollectionResourceConfig("collection_name", "rw"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_user_permission.collection", "collection", "collection_name"),
+					resource.TestCheckResourceAttr("arangodb_user_permission.collection", "id", "user/database_name/collection_name"),
+					resource.TestCheckResourceAttr("arangodb_user_permission.collection", "permission", "rw"),
+				),
+			},
+			// Collection ImportState testing
+			{
+				ResourceName:      "arangodb_user_permission.collection",
+				ImportState:       true,
+				ImportStateId:     "user/database_name/collection_name",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -61,3 +79,19 @@ resource "arangodb_user_permission" "test" {
 }
 `, databaseName, permission, userName)
 }
+
+func testUserPermissionCollectionResourceConfig(collectionName string, permission string) string {
+	return fmt.Sprintf(`
+resource "arangodb_collection" "test" {
+  database = arangodb_database.test.name
+  name     = %[1]q
+}
+
+resource "arangodb_user_permission" "collection" {
+  collection = arangodb_collection.test.name
+  database   = arangodb_database.test.name
+  permission = %[2]q
+  user       = arangodb_user.test.user
+}
+`, collectionName, permission)
+}
@@ -5,16 +5,25 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/arangodb/go-driver/v2/arangodb"
 	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -27,21 +36,28 @@ func NewUserResource() resource.Resource {
 
 // UserResource defines the resource implementation.
 type UserResource struct {
-	client arangodb.Client
+	client         arangodb.Client
+	defaultTimeout time.Duration
 }
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	Active   types.Bool   `tfsdk:"active"`
-	Password types.String `tfsdk:"password"`
-	User     types.String `tfsdk:"user"`
+	Active            types.Bool           `tfsdk:"active"`
+	AdoptExisting     types.Bool           `tfsdk:"adopt_existing"`
+	Extra             jsontypes.Normalized `tfsdk:"extra"`
+	Password          types.String         `tfsdk:"password"`
+	PasswordWo        types.String         `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64          `tfsdk:"password_wo_version"`
+	Timeouts          timeouts.Value       `tfsdk:"timeouts"`
+	User              types.String         `tfsdk:"user"`
+	VerifyPassword    types.Bool           `tfsdk:"verify_password"`
 }
 
 func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
-func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "An Arango user can access some defined databases",
@@ -53,13 +69,47 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether an existing user with the same name is taken over and updated to match the configuration " +
+					"instead of failing, defaults to false",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"extra": schema.StringAttribute{
+				MarkdownDescription: "Extra data of the user as a JSON encoded object, such as a display name, " +
+					"key ordering and whitespace are ignored when comparing. It is stored but not interpreted by the server, " +
+					"removing it clears the extra data of the user",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The user password",
-				Required:            true,
+				MarkdownDescription: "The user password, stored in the Terraform state. Exactly one of `password` and `password_wo` must be set",
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The user password, never stored in the Terraform state. " +
+					"It is only sent to the server when the user is created or `password_wo_version` changes, requires Terraform 1.11 or later",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`, change it to update the password of the user",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"user": schema.StringAttribute{
 				MarkdownDescription: "The name of the user",
@@ -68,6 +118,21 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 				Required: true,
 			},
+			"verify_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether the stored `password` is checked against the server on refresh, defaults to false. " +
+					"A password changed outside of Terraform is reset on the next apply, `password_wo` cannot be checked",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -78,18 +143,19 @@ func (r *UserResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*arangodb.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *arangodb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = *client
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -102,8 +168,39 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	_, err := r.client.CreateUser(ctx, data.User.ValueString(), toUserOptions(data))
-	if err != nil && !shared.IsConflict(err) {
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Write-only values are only available in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &data.PasswordWo)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateUser(ctx, data.User.ValueString(), toUserOptions(data))
+
+	// An existing user is only taken over when asked to, it is updated to match the plan.
+	if shared.IsConflict(err) && data.AdoptExisting.ValueBool() {
+		user, err = r.client.UpdateUser(ctx, data.User.ValueString(), toUserOptions(data))
+	} else if shared.IsConflict(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user"),
+			"User Already Exists",
+			fmt.Sprintf("The user %q already exists, import it instead or set adopt_existing to take it over.", data.User.ValueString()),
+		)
+
+		return
+	}
+
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create User "+
 				data.User.ValueString(),
@@ -115,6 +212,9 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	data.Active = types.BoolValue(user.IsActive())
+	data.Extra = fromUserExtra(data.Extra, user)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -129,6 +229,16 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	user, err := r.client.User(ctx, data.User.ValueString())
 
 	if err != nil {
@@ -147,21 +257,62 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data.Active = types.BoolValue(user.IsActive())
 	data.User = types.StringValue(user.Name())
+	data.Extra = fromUserExtra(data.Extra, user)
+
+	// Inactive users cannot log in, their password cannot be checked.
+	if data.VerifyPassword.ValueBool() && !data.Password.IsNull() && user.IsActive() {
+		valid, err := verifyPassword(ctx, r.client.Connection(), user.Name(), data.Password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Verify Password",
+				"An unexpected error occurred while attempting to verify the password of the user. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		// A password which differs from the configuration is planned to be reset.
+		if !valid {
+			data.Password = types.StringNull()
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data UserResourceModel
+	var data, state UserResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The write-only password is only sent when its version changes, the server keeps the password otherwise.
+	if !data.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &data.PasswordWo)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	user, err := r.client.UpdateUser(ctx, data.User.ValueString(), toUserOptions(data))
 
 	if err != nil {
@@ -177,6 +328,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	data.Active = types.BoolValue(user.IsActive())
 	data.User = types.StringValue(user.Name())
+	data.Extra = fromUserExtra(data.Extra, user)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -192,6 +344,16 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.RemoveUser(ctx, data.User.ValueString())
 
 	if err != nil && !shared.IsNotFound(err) {
@@ -208,11 +370,70 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("user"), req, resp)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_password"), false)...)
+}
+
+// verifyPassword reports whether the server accepts the password of the user.
+func verifyPassword(ctx context.Context, conn connection.Connection, username string, password string) (bool, error) {
+	var response shared.ResponseStruct
+
+	request := map[string]string{
+		"username": username,
+		"password": password,
+	}
+
+	resp, err := connection.CallPost(ctx, conn, connection.NewUrl("_open", "auth"), &response, request)
+	if err != nil {
+		return false, err
+	}
+
+	switch resp.Code() {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusUnauthorized:
+		return false, nil
+	default:
+		return false, response.AsArangoErrorWithCode(resp.Code())
+	}
 }
 
 func toUserOptions(data UserResourceModel) *arangodb.UserOptions {
-	return &arangodb.UserOptions{
+	password := data.Password.ValueString()
+
+	if !data.PasswordWo.IsNull() {
+		password = data.PasswordWo.ValueString()
+	}
+
+	options := &arangodb.UserOptions{
 		Active:   data.Active.ValueBoolPointer(),
-		Password: data.Password.ValueString(),
+		Password: password,
 	}
+
+	// Users without configured extra data are given an empty object, clearing extra data set before.
+	options.Extra = json.RawMessage("{}")
+
+	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
+		options.Extra = json.RawMessage(data.Extra.ValueString())
+	}
+
+	return options
+}
+
+// fromUserExtra returns the extra data of the user, the server omits it for users without extra data.
+// An empty object is kept as null when no extra data is configured.
+func fromUserExtra(configured jsontypes.Normalized, user arangodb.User) jsontypes.Normalized {
+	var extra json.RawMessage
+	var object map[string]any
+
+	if err := user.Extra(&extra); err != nil || len(extra) == 0 || string(extra) == "null" {
+		extra = json.RawMessage("{}")
+	}
+
+	if configured.IsNull() && json.Unmarshal(extra, &object) == nil && len(object) == 0 {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(extra))
 }
@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/arangodb/go-driver/v2/connection"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccUserResource(t *testing.T) {
@@ -24,6 +30,9 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("arangodb_user.test", "active", "true"),
 					resource.TestCheckResourceAttr("arangodb_user.test", "user", "one"),
 					resource.TestCheckResourceAttr("arangodb_user.test", "password", "1234"),
+					resource.TestCheckResourceAttr("arangodb_user.test", "adopt_existing", "false"),
+					resource.TestCheckResourceAttr("arangodb_user.test", "verify_password", "false"),
+					resource.TestCheckResourceAttr("arangodb_user.test", "extra", `{"name":"One"}`),
 				),
 			},
 			//// ImportState testing
@@ -40,6 +49,23 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("arangodb_user.test", "user", "two"),
 				),
 			},
+			// Extra data removal testing
+			{
+				Config: testUserResourceWithoutExtraConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("arangodb_user.test", "extra"),
+					testAccCheckUserExtraCleared("two"),
+				),
+			},
+			// Write-only password testing
+			{
+				Config: testUserResourceWriteOnlyConfig("two", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("arangodb_user.test", "password"),
+					resource.TestCheckNoResourceAttr("arangodb_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("arangodb_user.test", "password_wo_version", "1"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -47,6 +73,17 @@ func TestAccUserResource(t *testing.T) {
 
 func testUserResourceConfig(name string) string {
 	return providerConfig + fmt.Sprintf(`
+resource "arangodb_user" "test" {
+  active = true
+  user = %[1]q
+  password = "1234"
+  extra = jsonencode({ name = "One" })
+}
+`, name)
+}
+
+func testUserResourceWithoutExtraConfig(name string) string {
+	return providerConfig + fmt.Sprintf(`
 resource "arangodb_user" "test" {
   active = true
   user = %[1]q
@@ -54,3 +91,72 @@ resource "arangodb_user" "test" {
 }
 `, name)
 }
+
+func testAccCheckUserExtraCleared(name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		user, err := client.User(context.Background(), name)
+		if err != nil {
+			return err
+		}
+
+		var extra map[string]any
+
+		if err := user.Extra(&extra); err == nil && len(extra) > 0 {
+			return fmt.Errorf("expected the extra data of user %q to be cleared, got: %v", name, extra)
+		}
+
+		return nil
+	}
+}
+
+func testUserResourceWriteOnlyConfig(name string, version int) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_user" "test" {
+  user                = %[1]q
+  password_wo         = "5678"
+  password_wo_version = %[2]d
+}
+`, name, version)
+}
+
+func TestVerifyPassword(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path != "/_open/auth":
+			w.WriteHeader(http.StatusNotFound)
+		case request.Password == "secret":
+			_, _ = w.Write([]byte(`{"jwt":"token"}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":true,"code":401,"errorNum":401,"errorMessage":"Wrong credentials"}`))
+		}
+	}))
+	defer server.Close()
+
+	conn := connection.NewHttpConnection(httpConnectionConfig(connection.NewRoundRobinEndpoints([]string{server.URL}), http.DefaultTransport))
+
+	for password, expected := range map[string]bool{"secret": true, "changed": false} {
+		valid, err := verifyPassword(context.Background(), conn, "user", password)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if valid != expected {
+			t.Errorf("expected password %q to be valid %t, got %t", password, expected, valid)
+		}
+	}
+}
@@ -0,0 +1,1003 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArangoSearchViewResource{}
+var _ resource.ResourceWithImportState = &ArangoSearchViewResource{}
+
+func NewArangoSearchViewResource() resource.Resource {
+	return &ArangoSearchViewResource{}
+}
+
+// ArangoSearchViewResource defines the resource implementation.
+type ArangoSearchViewResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+}
+
+// ArangoSearchViewResourceModel describes the resource data model.
+type ArangoSearchViewResourceModel struct {
+	CleanupIntervalStep       types.Int64    `tfsdk:"cleanup_interval_step"`
+	CommitIntervalMsec        types.Int64    `tfsdk:"commit_interval_msec"`
+	ConsolidationIntervalMsec types.Int64    `tfsdk:"consolidation_interval_msec"`
+	ConsolidationPolicy       types.Object   `tfsdk:"consolidation_policy"`
+	Database                  types.String   `tfsdk:"database"`
+	Id                        types.String   `tfsdk:"id"`
+	Links                     types.Map      `tfsdk:"links"`
+	Name                      types.String   `tfsdk:"name"`
+	PrimarySort               types.List     `tfsdk:"primary_sort"`
+	PrimarySortCompression    types.String   `tfsdk:"primary_sort_compression"`
+	StoredValues              types.List     `tfsdk:"stored_values"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ArangoSearchViewLinkModel describes how the documents of a linked collection are indexed.
+type ArangoSearchViewLinkModel struct {
+	Analyzers          types.List   `tfsdk:"analyzers"`
+	Fields             types.Map    `tfsdk:"fields"`
+	IncludeAllFields   types.Bool   `tfsdk:"include_all_fields"`
+	StoreValues        types.String `tfsdk:"store_values"`
+	TrackListPositions types.Bool   `tfsdk:"track_list_positions"`
+}
+
+// ArangoSearchViewLinkFieldModel describes how a single document attribute of a link is indexed.
+type ArangoSearchViewLinkFieldModel struct {
+	Analyzers          types.List `tfsdk:"analyzers"`
+	IncludeAllFields   types.Bool `tfsdk:"include_all_fields"`
+	TrackListPositions types.Bool `tfsdk:"track_list_positions"`
+}
+
+// ArangoSearchViewPrimarySortModel describes a single primary sort entry.
+type ArangoSearchViewPrimarySortModel struct {
+	Ascending types.Bool   `tfsdk:"ascending"`
+	Field     types.String `tfsdk:"field"`
+}
+
+// ArangoSearchViewStoredValueModel describes a group of attributes stored in the view index.
+type ArangoSearchViewStoredValueModel struct {
+	Compression types.String `tfsdk:"compression"`
+	Fields      types.List   `tfsdk:"fields"`
+}
+
+// ArangoSearchViewConsolidationPolicyModel describes when segments of the view are consolidated.
+type ArangoSearchViewConsolidationPolicyModel struct {
+	MinScore           types.Int64   `tfsdk:"min_score"`
+	SegmentsBytesFloor types.Int64   `tfsdk:"segments_bytes_floor"`
+	SegmentsBytesMax   types.Int64   `tfsdk:"segments_bytes_max"`
+	SegmentsMax        types.Int64   `tfsdk:"segments_max"`
+	SegmentsMin        types.Int64   `tfsdk:"segments_min"`
+	Threshold          types.Float64 `tfsdk:"threshold"`
+	Type               types.String  `tfsdk:"type"`
+}
+
+var arangoSearchViewLinkFieldAttrTypes = map[string]attr.Type{
+	"analyzers":            types.ListType{ElemType: types.StringType},
+	"include_all_fields":   types.BoolType,
+	"track_list_positions": types.BoolType,
+}
+
+var arangoSearchViewLinkAttrTypes = map[string]attr.Type{
+	"analyzers":            types.ListType{ElemType: types.StringType},
+	"fields":               types.MapType{ElemType: types.ObjectType{AttrTypes: arangoSearchViewLinkFieldAttrTypes}},
+	"include_all_fields":   types.BoolType,
+	"store_values":         types.StringType,
+	"track_list_positions": types.BoolType,
+}
+
+var arangoSearchViewPrimarySortAttrTypes = map[string]attr.Type{
+	"ascending": types.BoolType,
+	"field":     types.StringType,
+}
+
+var arangoSearchViewStoredValueAttrTypes = map[string]attr.Type{
+	"compression": types.StringType,
+	"fields":      types.ListType{ElemType: types.StringType},
+}
+
+var arangoSearchViewConsolidationPolicyAttrTypes = map[string]attr.Type{
+	"min_score":            types.Int64Type,
+	"segments_bytes_floor": types.Int64Type,
+	"segments_bytes_max":   types.Int64Type,
+	"segments_max":         types.Int64Type,
+	"segments_min":         types.Int64Type,
+	"threshold":            types.Float64Type,
+	"type":                 types.StringType,
+}
+
+func (r *ArangoSearchViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view_arangosearch"
+}
+
+func (r *ArangoSearchViewResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An ArangoSearch view indexes the documents of linked collections for full-text search",
+
+		Attributes: map[string]schema.Attribute{
+			"cleanup_interval_step": schema.Int64Attribute{
+				MarkdownDescription: "Number of commits to wait between removing unused files, 0 disables the cleanup",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"commit_interval_msec": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds to wait between committing data changes and making them visible to queries",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"consolidation_interval_msec": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds to wait between applying the consolidation policy, 0 disables the consolidation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"consolidation_policy": schema.SingleNestedAttribute{
+				MarkdownDescription: "Thresholds deciding when index segments are merged",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"min_score": schema.Int64Attribute{
+						MarkdownDescription: "Minimum score a consolidation candidate must reach, `tier` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"segments_bytes_floor": schema.Int64Attribute{
+						MarkdownDescription: "Segments smaller than this many bytes are treated as equal, `tier` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"segments_bytes_max": schema.Int64Attribute{
+						MarkdownDescription: "Maximum size in bytes of all consolidated segments, `tier` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"segments_max": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of segments evaluated as candidates, `tier` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"segments_min": schema.Int64Attribute{
+						MarkdownDescription: "Minimum number of segments evaluated as candidates, `tier` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"threshold": schema.Float64Attribute{
+						MarkdownDescription: "Consolidation threshold in the range [0.0, 1.0], `bytes_accum` policy only",
+						Optional:            true,
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Policy type, can be 'tier' or 'bytes_accum'",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(arangodb.ArangoSearchConsolidationPolicyTypeTier),
+								string(arangodb.ArangoSearchConsolidationPolicyTypeBytesAccum),
+							),
+						},
+					},
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "View identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"links": schema.MapNestedAttribute{
+				MarkdownDescription: "Collections indexed by the view, keyed by collection name",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"analyzers": schema.ListAttribute{
+							MarkdownDescription: "Analyzers applied to string values, defaults to ['identity']",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("identity")})),
+						},
+						"fields": schema.MapNestedAttribute{
+							MarkdownDescription: "Per attribute indexing options, keyed by attribute name",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"analyzers": schema.ListAttribute{
+										MarkdownDescription: "Analyzers applied to string values, inherited from the link when not set",
+										ElementType:         types.StringType,
+										Optional:            true,
+									},
+									"include_all_fields": schema.BoolAttribute{
+										MarkdownDescription: "Whether all nested attributes are indexed, inherited from the link when not set",
+										Optional:            true,
+									},
+									"track_list_positions": schema.BoolAttribute{
+										MarkdownDescription: "Whether the position of array values is tracked, inherited from the link when not set",
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"include_all_fields": schema.BoolAttribute{
+							MarkdownDescription: "Whether all document attributes are indexed, defaults to false",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"store_values": schema.StringAttribute{
+							MarkdownDescription: "Whether value presence is stored for the `EXISTS()` function, can be 'none' or 'id', defaults to 'none'",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(arangodb.ArangoSearchStoreValuesNone)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(arangodb.ArangoSearchStoreValuesNone),
+									string(arangodb.ArangoSearchStoreValuesID),
+								),
+							},
+						},
+						"track_list_positions": schema.BoolAttribute{
+							MarkdownDescription: "Whether the position of array values is tracked, defaults to false",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "View name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"primary_sort": schema.ListNestedAttribute{
+				MarkdownDescription: "Sort order of the documents in the view index, cannot be changed after creation",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ascending": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is sorted in ascending order, defaults to true",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"field": schema.StringAttribute{
+							MarkdownDescription: "Attribute path to sort by",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"primary_sort_compression": schema.StringAttribute{
+				MarkdownDescription: "Compression of the primary sort data, can be 'lz4' or 'none', defaults to 'lz4'. Cannot be changed after creation",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(arangodb.PrimarySortCompressionLz4)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(arangodb.PrimarySortCompressionLz4),
+						string(arangodb.PrimarySortCompressionNone),
+					),
+				},
+			},
+			"stored_values": schema.ListNestedAttribute{
+				MarkdownDescription: "Document attributes stored in the view index to cover queries, cannot be changed after creation",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"compression": schema.StringAttribute{
+							MarkdownDescription: "Compression of the stored values, can be 'lz4' or 'none', defaults to 'lz4'",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(arangodb.PrimarySortCompressionLz4)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(arangodb.PrimarySortCompressionLz4),
+									string(arangodb.PrimarySortCompressionNone),
+								),
+							},
+						},
+						"fields": schema.ListAttribute{
+							MarkdownDescription: "Attribute paths stored together",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ArangoSearchViewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+}
+
+func (r *ArangoSearchViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArangoSearchViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	properties, diags := toArangoSearchViewProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	view, err := database.CreateArangoSearchView(ctx, data.Name.ValueString(), &properties)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	viewProperties, err := view.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the created resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromArangoSearchViewProperties(ctx, &data, viewProperties)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArangoSearchViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArangoSearchViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Database",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	arangoSearchView, err := view.ArangoSearchView()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected View Type",
+			fmt.Sprintf("The view %q is not an ArangoSearch view: %s", data.Name.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	properties, err := arangoSearchView.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromArangoSearchViewProperties(ctx, &data, properties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArangoSearchViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ArangoSearchViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	properties, diags := toArangoSearchViewProperties(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing View",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	arangoSearchView, err := view.ArangoSearchView()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected View Type",
+			fmt.Sprintf("The view %q is not an ArangoSearch view: %s", data.Name.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	// Replace all properties so that links removed from the configuration are dropped.
+	err = arangoSearchView.SetProperties(ctx, properties)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	viewProperties, err := arangoSearchView.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the updated resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromArangoSearchViewProperties(ctx, &data, viewProperties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArangoSearchViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArangoSearchViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err == nil {
+		err = view.Remove(ctx)
+	}
+
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *ArangoSearchViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/view. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func toArangoSearchViewProperties(ctx context.Context, data ArangoSearchViewResourceModel) (arangodb.ArangoSearchViewProperties, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	properties := arangodb.ArangoSearchViewProperties{
+		CleanupIntervalStep:    int64Pointer(data.CleanupIntervalStep),
+		CommitInterval:         int64Pointer(data.CommitIntervalMsec),
+		ConsolidationInterval:  int64Pointer(data.ConsolidationIntervalMsec),
+		PrimarySortCompression: arangodb.PrimarySortCompression(data.PrimarySortCompression.ValueString()),
+	}
+
+	if !data.ConsolidationPolicy.IsNull() && !data.ConsolidationPolicy.IsUnknown() {
+		var policy ArangoSearchViewConsolidationPolicyModel
+
+		diags.Append(data.ConsolidationPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+
+		properties.ConsolidationPolicy = &arangodb.ArangoSearchConsolidationPolicy{
+			Type: arangodb.ArangoSearchConsolidationPolicyType(policy.Type.ValueString()),
+			ArangoSearchConsolidationPolicyBytesAccum: arangodb.ArangoSearchConsolidationPolicyBytesAccum{
+				Threshold: float64Pointer(policy.Threshold),
+			},
+			ArangoSearchConsolidationPolicyTier: arangodb.ArangoSearchConsolidationPolicyTier{
+				MinScore:           int64Pointer(policy.MinScore),
+				MinSegments:        int64Pointer(policy.SegmentsMin),
+				MaxSegments:        int64Pointer(policy.SegmentsMax),
+				SegmentsBytesMax:   int64Pointer(policy.SegmentsBytesMax),
+				SegmentsBytesFloor: int64Pointer(policy.SegmentsBytesFloor),
+			},
+		}
+	}
+
+	if !data.Links.IsNull() && !data.Links.IsUnknown() {
+		var links map[string]ArangoSearchViewLinkModel
+
+		diags.Append(data.Links.ElementsAs(ctx, &links, false)...)
+
+		properties.Links = arangodb.ArangoSearchLinks{}
+
+		for collection, link := range links {
+			element := arangodb.ArangoSearchElementProperties{
+				IncludeAllFields:   link.IncludeAllFields.ValueBoolPointer(),
+				TrackListPositions: link.TrackListPositions.ValueBoolPointer(),
+				StoreValues:        arangodb.ArangoSearchStoreValues(link.StoreValues.ValueString()),
+			}
+
+			diags.Append(link.Analyzers.ElementsAs(ctx, &element.Analyzers, false)...)
+
+			if !link.Fields.IsNull() && !link.Fields.IsUnknown() {
+				var fields map[string]ArangoSearchViewLinkFieldModel
+
+				diags.Append(link.Fields.ElementsAs(ctx, &fields, false)...)
+
+				element.Fields = arangodb.ArangoSearchFields{}
+
+				for name, field := range fields {
+					fieldElement := arangodb.ArangoSearchElementProperties{
+						IncludeAllFields:   field.IncludeAllFields.ValueBoolPointer(),
+						TrackListPositions: field.TrackListPositions.ValueBoolPointer(),
+					}
+
+					if !field.Analyzers.IsNull() && !field.Analyzers.IsUnknown() {
+						diags.Append(field.Analyzers.ElementsAs(ctx, &fieldElement.Analyzers, false)...)
+					}
+
+					element.Fields[name] = fieldElement
+				}
+			}
+
+			properties.Links[collection] = element
+		}
+	}
+
+	if !data.PrimarySort.IsNull() && !data.PrimarySort.IsUnknown() {
+		var primarySort []ArangoSearchViewPrimarySortModel
+
+		diags.Append(data.PrimarySort.ElementsAs(ctx, &primarySort, false)...)
+
+		for _, entry := range primarySort {
+			properties.PrimarySort = append(properties.PrimarySort, arangodb.ArangoSearchPrimarySortEntry{
+				Field:     entry.Field.ValueString(),
+				Ascending: entry.Ascending.ValueBoolPointer(),
+			})
+		}
+	}
+
+	if !data.StoredValues.IsNull() && !data.StoredValues.IsUnknown() {
+		var storedValues []ArangoSearchViewStoredValueModel
+
+		diags.Append(data.StoredValues.ElementsAs(ctx, &storedValues, false)...)
+
+		for _, storedValue := range storedValues {
+			value := arangodb.StoredValue{
+				Compression: arangodb.PrimarySortCompression(storedValue.Compression.ValueString()),
+			}
+
+			diags.Append(storedValue.Fields.ElementsAs(ctx, &value.Fields, false)...)
+
+			properties.StoredValues = append(properties.StoredValues, value)
+		}
+	}
+
+	return properties, diags
+}
+
+// fromArangoSearchViewProperties copies the server side view properties into the model.
+// Links are always taken from the server so that changes made outside of Terraform show up in plans.
+func fromArangoSearchViewProperties(ctx context.Context, data *ArangoSearchViewResourceModel, properties arangodb.ArangoSearchViewProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(properties.ID)
+	data.Name = types.StringValue(properties.Name)
+	data.CleanupIntervalStep = types.Int64PointerValue(properties.CleanupIntervalStep)
+	data.CommitIntervalMsec = types.Int64PointerValue(properties.CommitInterval)
+	data.ConsolidationIntervalMsec = types.Int64PointerValue(properties.ConsolidationInterval)
+	data.PrimarySortCompression = types.StringValue(string(arangodb.PrimarySortCompressionLz4))
+
+	if properties.PrimarySortCompression != "" {
+		data.PrimarySortCompression = types.StringValue(string(properties.PrimarySortCompression))
+	}
+
+	if properties.ConsolidationPolicy != nil {
+		policy := properties.ConsolidationPolicy
+
+		policyValue, policyDiags := types.ObjectValueFrom(ctx, arangoSearchViewConsolidationPolicyAttrTypes, ArangoSearchViewConsolidationPolicyModel{
+			MinScore:           types.Int64PointerValue(policy.MinScore),
+			SegmentsBytesFloor: types.Int64PointerValue(policy.SegmentsBytesFloor),
+			SegmentsBytesMax:   types.Int64PointerValue(policy.SegmentsBytesMax),
+			SegmentsMax:        types.Int64PointerValue(policy.MaxSegments),
+			SegmentsMin:        types.Int64PointerValue(policy.MinSegments),
+			Threshold:          types.Float64PointerValue(policy.Threshold),
+			Type:               types.StringValue(string(policy.Type)),
+		})
+		diags.Append(policyDiags...)
+		data.ConsolidationPolicy = policyValue
+	} else {
+		data.ConsolidationPolicy = types.ObjectNull(arangoSearchViewConsolidationPolicyAttrTypes)
+	}
+
+	diags.Append(fromArangoSearchLinks(ctx, data, properties.Links)...)
+
+	primarySortType := types.ObjectType{AttrTypes: arangoSearchViewPrimarySortAttrTypes}
+
+	if len(properties.PrimarySort) > 0 {
+		primarySort := make([]ArangoSearchViewPrimarySortModel, 0, len(properties.PrimarySort))
+
+		for _, entry := range properties.PrimarySort {
+			primarySort = append(primarySort, ArangoSearchViewPrimarySortModel{
+				Ascending: types.BoolValue(entry.GetAscending()),
+				Field:     types.StringValue(entry.Field),
+			})
+		}
+
+		primarySortValue, primarySortDiags := types.ListValueFrom(ctx, primarySortType, primarySort)
+		diags.Append(primarySortDiags...)
+		data.PrimarySort = primarySortValue
+	} else if data.PrimarySort.IsNull() || data.PrimarySort.IsUnknown() {
+		data.PrimarySort = types.ListNull(primarySortType)
+	} else {
+		data.PrimarySort = types.ListValueMust(primarySortType, []attr.Value{})
+	}
+
+	storedValueType := types.ObjectType{AttrTypes: arangoSearchViewStoredValueAttrTypes}
+
+	if len(properties.StoredValues) > 0 {
+		storedValues := make([]ArangoSearchViewStoredValueModel, 0, len(properties.StoredValues))
+
+		for _, storedValue := range properties.StoredValues {
+			fields, fieldsDiags := types.ListValueFrom(ctx, types.StringType, storedValue.Fields)
+			diags.Append(fieldsDiags...)
+
+			compression := arangodb.PrimarySortCompressionLz4
+			if storedValue.Compression != "" {
+				compression = storedValue.Compression
+			}
+
+			storedValues = append(storedValues, ArangoSearchViewStoredValueModel{
+				Compression: types.StringValue(string(compression)),
+				Fields:      fields,
+			})
+		}
+
+		storedValuesValue, storedValuesDiags := types.ListValueFrom(ctx, storedValueType, storedValues)
+		diags.Append(storedValuesDiags...)
+		data.StoredValues = storedValuesValue
+	} else if data.StoredValues.IsNull() || data.StoredValues.IsUnknown() {
+		data.StoredValues = types.ListNull(storedValueType)
+	} else {
+		data.StoredValues = types.ListValueMust(storedValueType, []attr.Value{})
+	}
+
+	return diags
+}
+
+func fromArangoSearchLinks(ctx context.Context, data *ArangoSearchViewResourceModel, links arangodb.ArangoSearchLinks) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var prior map[string]ArangoSearchViewLinkModel
+
+	linkType := types.ObjectType{AttrTypes: arangoSearchViewLinkAttrTypes}
+	fieldType := types.ObjectType{AttrTypes: arangoSearchViewLinkFieldAttrTypes}
+
+	if len(links) == 0 {
+		if data.Links.IsNull() || data.Links.IsUnknown() {
+			data.Links = types.MapNull(linkType)
+		} else {
+			data.Links = types.MapValueMust(linkType, map[string]attr.Value{})
+		}
+
+		return diags
+	}
+
+	if !data.Links.IsNull() && !data.Links.IsUnknown() {
+		diags.Append(data.Links.ElementsAs(ctx, &prior, false)...)
+	}
+
+	models := make(map[string]ArangoSearchViewLinkModel, len(links))
+
+	for collection, link := range links {
+		analyzers := link.Analyzers
+		if analyzers == nil {
+			analyzers = []string{}
+		}
+
+		analyzersValue, analyzersDiags := types.ListValueFrom(ctx, types.StringType, analyzers)
+		diags.Append(analyzersDiags...)
+
+		storeValues := arangodb.ArangoSearchStoreValuesNone
+		if link.StoreValues != "" {
+			storeValues = link.StoreValues
+		}
+
+		model := ArangoSearchViewLinkModel{
+			Analyzers:          analyzersValue,
+			Fields:             types.MapNull(fieldType),
+			IncludeAllFields:   types.BoolValue(link.IncludeAllFields != nil && *link.IncludeAllFields),
+			StoreValues:        types.StringValue(string(storeValues)),
+			TrackListPositions: types.BoolValue(link.TrackListPositions != nil && *link.TrackListPositions),
+		}
+
+		if len(link.Fields) > 0 {
+			fields := make(map[string]ArangoSearchViewLinkFieldModel, len(link.Fields))
+
+			for name, field := range link.Fields {
+				fieldModel := ArangoSearchViewLinkFieldModel{
+					Analyzers:          types.ListNull(types.StringType),
+					IncludeAllFields:   types.BoolPointerValue(field.IncludeAllFields),
+					TrackListPositions: types.BoolPointerValue(field.TrackListPositions),
+				}
+
+				if field.Analyzers != nil {
+					fieldAnalyzers, fieldAnalyzersDiags := types.ListValueFrom(ctx, types.StringType, field.Analyzers)
+					diags.Append(fieldAnalyzersDiags...)
+					fieldModel.Analyzers = fieldAnalyzers
+				}
+
+				fields[name] = fieldModel
+			}
+
+			fieldsValue, fieldsDiags := types.MapValueFrom(ctx, fieldType, fields)
+			diags.Append(fieldsDiags...)
+			model.Fields = fieldsValue
+		} else if priorLink, ok := prior[collection]; ok && !priorLink.Fields.IsNull() && !priorLink.Fields.IsUnknown() {
+			model.Fields = types.MapValueMust(fieldType, map[string]attr.Value{})
+		}
+
+		models[collection] = model
+	}
+
+	linksValue, linksDiags := types.MapValueFrom(ctx, linkType, models)
+	diags.Append(linksDiags...)
+	data.Links = linksValue
+
+	return diags
+}
+
+// int64Pointer returns nil for null or unknown values so that the server default applies.
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueInt64Pointer()
+}
+
+// float64Pointer returns nil for null or unknown values so that the server default applies.
+func float64Pointer(value types.Float64) *float64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueFloat64Pointer()
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArangoSearchViewResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccArangoSearchViewResourceConfig("view_database", "view", 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "name", "view"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "commit_interval_msec", "1000"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "links.view_collection.include_all_fields", "false"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "links.view_collection.fields.title.analyzers.0", "text_en"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "primary_sort.0.field", "title"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "stored_values.0.fields.0", "title"),
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "consolidation_policy.type", "tier"),
+					resource.TestCheckResourceAttrSet("arangodb_view_arangosearch.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "arangodb_view_arangosearch.test",
+				ImportState:                          true,
+				ImportStateId:                        "view_database/view",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccArangoSearchViewResourceConfig("view_database", "view", 2000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_view_arangosearch.test", "commit_interval_msec", "2000"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccArangoSearchViewResourceConfig(databaseName string, name string, commitInterval int) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_collection" "test" {
+  database = arangodb_database.test.name
+  name     = "view_collection"
+}
+
+resource "arangodb_view_arangosearch" "test" {
+  database             = arangodb_database.test.name
+  name                 = %[2]q
+  commit_interval_msec = %[3]d
+
+  links = {
+    (arangodb_collection.test.name) = {
+      fields = {
+        title = {
+          analyzers = ["text_en"]
+        }
+      }
+    }
+  }
+
+  primary_sort = [
+    {
+      field = "title"
+    },
+  ]
+
+  stored_values = [
+    {
+      fields = ["title"]
+    },
+  ]
+
+  consolidation_policy = {
+    type         = "tier"
+    segments_max = 10
+  }
+}
+`, databaseName, name, commitInterval)
+}
@@ -0,0 +1,604 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/arangodb/go-driver/v2/arangodb/shared"
+	"github.com/arangodb/go-driver/v2/connection"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SearchAliasViewResource{}
+var _ resource.ResourceWithImportState = &SearchAliasViewResource{}
+var _ resource.ResourceWithModifyPlan = &SearchAliasViewResource{}
+
+func NewSearchAliasViewResource() resource.Resource {
+	return &SearchAliasViewResource{}
+}
+
+// SearchAliasViewResource defines the resource implementation.
+type SearchAliasViewResource struct {
+	client         arangodb.Client
+	defaultTimeout time.Duration
+	server         *ServerInfo
+}
+
+// SearchAliasViewResourceModel describes the resource data model.
+type SearchAliasViewResourceModel struct {
+	Database types.String   `tfsdk:"database"`
+	Id       types.String   `tfsdk:"id"`
+	Indexes  types.Set      `tfsdk:"indexes"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// SearchAliasViewIndexModel describes an inverted index referenced by the view.
+type SearchAliasViewIndexModel struct {
+	Collection types.String `tfsdk:"collection"`
+	Index      types.String `tfsdk:"index"`
+}
+
+// searchAliasViewIndexOperation is a single entry of a partial update of the view indexes.
+// The driver only supports a single operation for the whole request, the server expects one per index.
+type searchAliasViewIndexOperation struct {
+	Collection string                              `json:"collection"`
+	Index      string                              `json:"index"`
+	Operation  arangodb.ArangoSearchAliasOperation `json:"operation"`
+}
+
+var searchAliasViewIndexAttrTypes = map[string]attr.Type{
+	"collection": types.StringType,
+	"index":      types.StringType,
+}
+
+func (r *SearchAliasViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view_search_alias"
+}
+
+func (r *SearchAliasViewResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A search-alias view combines inverted indexes of one or more collections for search queries",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "View identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"indexes": schema.SetNestedAttribute{
+				MarkdownDescription: "Inverted indexes added to the view, changes are applied in place",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"collection": schema.StringAttribute{
+							MarkdownDescription: "Collection name",
+							Required:            true,
+						},
+						"index": schema.StringAttribute{
+							MarkdownDescription: "Name of an inverted index of the collection",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "View name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SearchAliasViewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.defaultTimeout = providerData.RequestTimeout
+	r.server = providerData.Server
+}
+
+func (r *SearchAliasViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data SearchAliasViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.server.Require(path.Empty(), serverRequirement{
+		feature:    "Search-alias views",
+		minVersion: "3.10.0",
+	})...)
+}
+
+func (r *SearchAliasViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SearchAliasViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	indexes, diags := toSearchAliasIndexes(ctx, data.Indexes)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	view, err := database.CreateArangoSearchAliasView(ctx, data.Name.ValueString(), &arangodb.ArangoSearchAliasViewProperties{
+		Indexes: indexes,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	properties, err := view.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the created resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromSearchAliasViewProperties(ctx, &data, properties)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SearchAliasViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SearchAliasViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to find existing Database",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err != nil {
+		if shared.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh resource state. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	aliasView, err := view.ArangoSearchViewAlias()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected View Type",
+			fmt.Sprintf("The view %q is not a search-alias view: %s", data.Name.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	properties, err := aliasView.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromSearchAliasViewProperties(ctx, &data, properties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SearchAliasViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SearchAliasViewResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	planned, diags := toSearchAliasIndexes(ctx, data.Indexes)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := toSearchAliasIndexes(ctx, state.Indexes)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing Database",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find existing View",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	aliasView, err := view.ArangoSearchViewAlias()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected View Type",
+			fmt.Sprintf("The view %q is not a search-alias view: %s", data.Name.ValueString(), err.Error()),
+		)
+
+		return
+	}
+
+	// Only add and remove the changed indexes so that the view stays queryable during the update.
+	operations := diffSearchAliasIndexes(current, planned)
+
+	if len(operations) > 0 {
+		err = r.updateIndexes(ctx, data.Database.ValueString(), data.Name.ValueString(), operations)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	properties, err := aliasView.Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while attempting to read the updated resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(fromSearchAliasViewProperties(ctx, &data, properties)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SearchAliasViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SearchAliasViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	database, err := r.client.GetDatabase(ctx, data.Database.ValueString(), nil)
+	if err != nil {
+		if !shared.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	view, err := database.View(ctx, data.Name.ValueString())
+	if err == nil {
+		err = view.Remove(ctx)
+	}
+
+	if err != nil && !shared.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *SearchAliasViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/view. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// updateIndexes partially updates the indexes of the view with one operation per index.
+func (r *SearchAliasViewResource) updateIndexes(ctx context.Context, database string, name string, operations []searchAliasViewIndexOperation) error {
+	urlEndpoint := connection.NewUrl("_db", url.PathEscape(database), "_api", "view", url.PathEscape(name), "properties")
+
+	body := struct {
+		Indexes []searchAliasViewIndexOperation `json:"indexes"`
+	}{
+		Indexes: operations,
+	}
+
+	var response shared.ResponseStruct
+
+	resp, err := connection.CallPatch(ctx, r.client.Connection(), urlEndpoint, &response, body)
+	if err != nil {
+		return err
+	}
+
+	if resp.Code() != http.StatusOK {
+		return response.AsArangoErrorWithCode(resp.Code())
+	}
+
+	return nil
+}
+
+// diffSearchAliasIndexes returns the operations turning the current indexes into the planned ones, removals first.
+func diffSearchAliasIndexes(current []arangodb.ArangoSearchAliasIndex, planned []arangodb.ArangoSearchAliasIndex) []searchAliasViewIndexOperation {
+	var operations []searchAliasViewIndexOperation
+
+	contains := func(indexes []arangodb.ArangoSearchAliasIndex, index arangodb.ArangoSearchAliasIndex) bool {
+		for _, candidate := range indexes {
+			if candidate == index {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, index := range current {
+		if !contains(planned, index) {
+			operations = append(operations, searchAliasViewIndexOperation{
+				Collection: index.Collection,
+				Index:      index.Index,
+				Operation:  arangodb.ArangoSearchAliasOperationDel,
+			})
+		}
+	}
+
+	for _, index := range planned {
+		if !contains(current, index) {
+			operations = append(operations, searchAliasViewIndexOperation{
+				Collection: index.Collection,
+				Index:      index.Index,
+				Operation:  arangodb.ArangoSearchAliasOperationAdd,
+			})
+		}
+	}
+
+	return operations
+}
+
+func toSearchAliasIndexes(ctx context.Context, set types.Set) ([]arangodb.ArangoSearchAliasIndex, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []SearchAliasViewIndexModel
+
+	indexes := []arangodb.ArangoSearchAliasIndex{}
+
+	if set.IsNull() || set.IsUnknown() {
+		return indexes, diags
+	}
+
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+
+	for _, model := range models {
+		indexes = append(indexes, arangodb.ArangoSearchAliasIndex{
+			Collection: model.Collection.ValueString(),
+			Index:      model.Index.ValueString(),
+		})
+	}
+
+	return indexes, diags
+}
+
+func fromSearchAliasViewProperties(ctx context.Context, data *SearchAliasViewResourceModel, properties arangodb.ArangoSearchAliasViewProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(properties.ID)
+	data.Name = types.StringValue(properties.Name)
+
+	indexType := types.ObjectType{AttrTypes: searchAliasViewIndexAttrTypes}
+
+	if len(properties.Indexes) == 0 {
+		if data.Indexes.IsNull() || data.Indexes.IsUnknown() {
+			data.Indexes = types.SetNull(indexType)
+		} else {
+			data.Indexes = types.SetValueMust(indexType, []attr.Value{})
+		}
+
+		return diags
+	}
+
+	indexes := make([]SearchAliasViewIndexModel, 0, len(properties.Indexes))
+
+	for _, index := range properties.Indexes {
+		indexes = append(indexes, SearchAliasViewIndexModel{
+			Collection: types.StringValue(index.Collection),
+			Index:      types.StringValue(index.Index),
+		})
+	}
+
+	indexesValue, indexesDiags := types.SetValueFrom(ctx, indexType, indexes)
+	diags.Append(indexesDiags...)
+	data.Indexes = indexesValue
+
+	return diags
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Predell Services
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/arangodb/go-driver/v2/arangodb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSearchAliasViewResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSearchAliasViewResourceConfig("search_alias_database", "search_alias", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_view_search_alias.test", "name", "search_alias"),
+					resource.TestCheckResourceAttr("arangodb_view_search_alias.test", "indexes.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("arangodb_view_search_alias.test", "indexes.*", map[string]string{
+						"collection": "search_alias_collection",
+						"index":      "first",
+					}),
+					resource.TestCheckResourceAttrSet("arangodb_view_search_alias.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "arangodb_view_search_alias.test",
+				ImportState:                          true,
+				ImportStateId:                        "search_alias_database/search_alias",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSearchAliasViewResourceConfig("search_alias_database", "search_alias", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("arangodb_view_search_alias.test", "indexes.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("arangodb_view_search_alias.test", "indexes.*", map[string]string{
+						"collection": "search_alias_collection",
+						"index":      "second",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDiffSearchAliasIndexes(t *testing.T) {
+	first := arangodb.ArangoSearchAliasIndex{Collection: "collection", Index: "first"}
+	second := arangodb.ArangoSearchAliasIndex{Collection: "collection", Index: "second"}
+	third := arangodb.ArangoSearchAliasIndex{Collection: "collection", Index: "third"}
+
+	operations := diffSearchAliasIndexes(
+		[]arangodb.ArangoSearchAliasIndex{first, second},
+		[]arangodb.ArangoSearchAliasIndex{second, third},
+	)
+
+	expected := []searchAliasViewIndexOperation{
+		{Collection: "collection", Index: "first", Operation: arangodb.ArangoSearchAliasOperationDel},
+		{Collection: "collection", Index: "third", Operation: arangodb.ArangoSearchAliasOperationAdd},
+	}
+
+	if !reflect.DeepEqual(operations, expected) {
+		t.Errorf("expected %v, got %v", expected, operations)
+	}
+
+	if operations := diffSearchAliasIndexes([]arangodb.ArangoSearchAliasIndex{first}, []arangodb.ArangoSearchAliasIndex{first}); len(operations) != 0 {
+		t.Errorf("expected no operations, got %v", operations)
+	}
+}
+
+func testAccSearchAliasViewResourceConfig(databaseName string, name string, indexName string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "arangodb_database" "test" {
+  name = %[1]q
+}
+
+resource "arangodb_collection" "test" {
+  database = arangodb_database.test.name
+  name     = "search_alias_collection"
+}
+
+resource "arangodb_index" "first" {
+  database   = arangodb_database.test.name
+  collection = arangodb_collection.test.name
+  name       = "first"
+  type       = "inverted"
+  fields     = ["title"]
+}
+
+resource "arangodb_index" "second" {
+  database   = arangodb_database.test.name
+  collection = arangodb_collection.test.name
+  name       = "second"
+  type       = "inverted"
+  fields     = ["body"]
+}
+
+resource "arangodb_view_search_alias" "test" {
+  database = arangodb_database.test.name
+  name     = %[2]q
+
+  indexes = [
+    {
+      collection = arangodb_collection.test.name
+      index      = arangodb_index.%[3]s.name
+    },
+  ]
+}
+`, databaseName, name, indexName)
+}